@@ -0,0 +1,130 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// Branch prefixes are the strongest signal: they were chosen by a human
+	// before the session started.
+	branchTypePrefixes = map[string]string{
+		"fix": "bugfix", "bugfix": "bugfix", "hotfix": "bugfix", "bug": "bugfix",
+		"feat": "feature", "feature": "feature",
+		"refactor": "refactor", "chore": "refactor", "cleanup": "refactor",
+		"debug": "debugging", "investigate": "debugging",
+		"spike": "exploration", "explore": "exploration", "research": "exploration", "poc": "exploration",
+	}
+
+	// Phrases are matched on word boundaries in lowercased prompt text.
+	promptTypeKeywords = map[string][]string{
+		"bugfix":      {"fix", "fixes", "bug", "broken", "regression", "crash", "crashes", "incorrect", "wrong", "fails", "failing"},
+		"debugging":   {"debug", "why does", "why is", "investigate", "stack trace", "traceback", "panic", "not working", "doesn't work", "error message"},
+		"refactor":    {"refactor", "clean up", "cleanup", "rename", "extract", "simplify", "restructure", "reorganize", "deduplicate", "move"},
+		"feature":     {"add", "implement", "create", "build", "support", "introduce", "new", "feature", "endpoint", "command"},
+		"exploration": {"explain", "how does", "what does", "what is", "understand", "explore", "walk me through", "overview", "where is", "look at"},
+	}
+
+	readTools = map[string]bool{"Read": true, "Grep": true, "Glob": true, "LS": true, "WebFetch": true, "WebSearch": true}
+
+	testCommandRegex = regexp.MustCompile(`\b(go test|npm (run )?test|yarn test|pnpm test|pytest|jest|vitest|cargo test|mvn test|gradle test|rspec|phpunit|make test)\b`)
+	nonWordRegex     = regexp.MustCompile(`[^a-z0-9']+`)
+)
+
+// classifySessionType picks one of sessionTypes for a transcript from local
+// signals: the branch name, prompt wording, how much the session read versus
+// edited, and whether it ran tests.
+func classifySessionType(parsed *parsedSession) string {
+	scores := map[string]float64{}
+
+	branch := strings.ToLower(strings.TrimSpace(parsed.GitBranch))
+	if i := strings.IndexAny(branch, "/-_"); i > 0 {
+		if t, ok := branchTypePrefixes[branch[:i]]; ok {
+			scores[t] += 3
+		}
+	}
+
+	// The opening request says most about intent. Follow-ups count by the
+	// share that mention a type, so a long session repeating one word does
+	// not drown out everything else.
+	seenFirst := false
+	followUps := 0
+	followUpHits := map[string]int{}
+	for _, it := range parsed.Interactions {
+		if it.GetInteractionType() != "prompt" || !isSubstantivePrompt(it.GetContent()) {
+			continue
+		}
+		matched := promptSessionTypes(it.GetContent())
+		if !seenFirst {
+			seenFirst = true
+			for t := range matched {
+				scores[t] += 2
+			}
+			continue
+		}
+		followUps++
+		for t := range matched {
+			followUpHits[t]++
+		}
+	}
+	for t, hits := range followUpHits {
+		scores[t] += 1.5 * float64(hits) / float64(followUps)
+	}
+
+	reads, edits, testRuns := 0, 0, 0
+	for _, use := range parsed.ToolUses {
+		switch {
+		case readTools[use.Name]:
+			reads++
+		case fileEditTools[use.Name]:
+			edits++
+		case use.Name == "Bash" && testCommandRegex.MatchString(asString(use.Input["command"])):
+			testRuns++
+		}
+	}
+	switch {
+	case edits == 0 && reads > 0:
+		scores["exploration"] += 2.5
+	case edits > 0 && reads >= 4*edits:
+		// Lots of reading for a small change: hunting for a cause.
+		scores["debugging"] += 1
+		scores["bugfix"] += 0.5
+	case edits >= 10:
+		scores["feature"] += 1
+		scores["refactor"] += 0.5
+	}
+	if testRuns >= 2 {
+		scores["bugfix"] += 0.5
+		scores["debugging"] += 0.5
+	}
+
+	best, bestScore := "", 0.0
+	for _, t := range sessionTypes {
+		if scores[t] > bestScore {
+			best, bestScore = t, scores[t]
+		}
+	}
+	if best == "" {
+		if edits > 0 {
+			return "feature"
+		}
+		return "exploration"
+	}
+	return best
+}
+
+// promptSessionTypes returns the session types whose keywords appear in a
+// prompt.
+func promptSessionTypes(prompt string) map[string]bool {
+	text := " " + strings.TrimSpace(nonWordRegex.ReplaceAllString(strings.ToLower(prompt), " ")) + " "
+	matched := map[string]bool{}
+	for t, phrases := range promptTypeKeywords {
+		for _, phrase := range phrases {
+			if strings.Contains(text, " "+phrase+" ") {
+				matched[t] = true
+				break
+			}
+		}
+	}
+	return matched
+}
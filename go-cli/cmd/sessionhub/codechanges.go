@@ -0,0 +1,185 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+
+	pb "github.com/sessionhuborg/plugin/go-cli/proto"
+)
+
+const (
+	maxFileChanges      = 200
+	maxFileDiffBytes    = 16 << 10
+	maxSessionDiffBytes = 256 << 10
+	diffContextLines    = 3
+
+	// excerptSeparator sits between unrelated edited regions of a file whose
+	// full content is unknown. It is identical on both sides of the diff, so
+	// it separates hunks without counting as a change.
+	excerptSeparator = "⋯\n"
+)
+
+// fileChangeState is the reconstruction of one file: before and after are
+// either the whole file, or (when partial) the edited regions joined by
+// excerptSeparator.
+type fileChangeState struct {
+	path    string
+	before  string
+	after   string
+	seen    bool
+	partial bool
+	created bool
+	edits   int
+}
+
+// collectFileChanges rebuilds what a session did to each file from its
+// Edit, MultiEdit and Write tool calls, in first-touched order. A file's
+// starting content is known when the session created it or Claude Code
+// recorded the original in the tool result; otherwise only the edited
+// regions are compared. Failed calls are ignored. Diffs are bounded per file
+// and per session; counts are always exact for what was reconstructed.
+func collectFileChanges(parsed *parsedSession) []*pb.FileChange {
+	states := map[string]*fileChangeState{}
+	order := make([]*fileChangeState, 0)
+	stateFor := func(use transcriptToolUse) *fileChangeState {
+		path := asString(use.Input["file_path"])
+		if path == "" {
+			return nil
+		}
+		st, ok := states[path]
+		if !ok {
+			if len(order) == maxFileChanges {
+				return nil
+			}
+			st = &fileChangeState{path: path}
+			states[path] = st
+			order = append(order, st)
+		}
+		if !st.seen && use.OriginalFile != nil {
+			st.before, st.after, st.seen = *use.OriginalFile, *use.OriginalFile, true
+		}
+		return st
+	}
+
+	for _, use := range parsed.ToolUses {
+		if use.Failed {
+			continue
+		}
+		switch use.Name {
+		case "Write":
+			st := stateFor(use)
+			if st == nil {
+				continue
+			}
+			if !st.seen && use.Created {
+				st.created = true
+			}
+			if !st.seen && !use.Created {
+				// Overwrote a file whose content we never saw.
+				st.partial = true
+			}
+			st.after = asString(use.Input["content"])
+			st.seen = true
+			st.edits++
+		case "Edit":
+			st := stateFor(use)
+			if st == nil {
+				continue
+			}
+			st.applyEdit(asString(use.Input["old_string"]), asString(use.Input["new_string"]), use.Input["replace_all"] == true)
+			st.edits++
+		case "MultiEdit":
+			st := stateFor(use)
+			if st == nil {
+				continue
+			}
+			edits, _ := use.Input["edits"].([]any)
+			for _, e := range edits {
+				em := asMap(e)
+				st.applyEdit(asString(em["old_string"]), asString(em["new_string"]), em["replace_all"] == true)
+			}
+			st.edits++
+		}
+	}
+
+	changes := make([]*pb.FileChange, 0, len(order))
+	diffBudget := maxSessionDiffBytes
+	for _, st := range order {
+		if st.before == st.after {
+			continue
+		}
+		name := st.path
+		if parsed.Cwd != "" {
+			if rel, err := filepath.Rel(parsed.Cwd, st.path); err == nil && !strings.HasPrefix(rel, "..") {
+				name = filepath.ToSlash(rel)
+			}
+		}
+		change := &pb.FileChange{Path: name, EditCount: int32(st.edits), Created: st.created, Partial: st.partial}
+		for _, op := range diffLines(splitDiffLines(st.before), splitDiffLines(st.after)) {
+			switch op.kind {
+			case '+':
+				change.LinesAdded++
+			case '-':
+				change.LinesRemoved++
+			}
+		}
+
+		from := "a/" + name
+		if st.created {
+			from = "/dev/null"
+		}
+		diff := unifiedDiff(from, "b/"+name, st.before, st.after, diffContextLines)
+		limit := min(maxFileDiffBytes, diffBudget)
+		if len(diff) > limit {
+			diff = truncateAtLine(diff, limit)
+			change.DiffTruncated = true
+		}
+		if diff != "" {
+			change.Diff = stringPtr(diff)
+			diffBudget -= len(diff)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// applyEdit replaces oldText with newText in the reconstruction. When oldText
+// is not in the known text (the file was never seen, or it changed outside
+// the session), the pair is recorded as a new excerpt instead.
+func (st *fileChangeState) applyEdit(oldText, newText string, replaceAll bool) {
+	if oldText != "" && strings.Contains(st.after, oldText) {
+		if replaceAll {
+			st.after = strings.ReplaceAll(st.after, oldText, newText)
+		} else {
+			st.after = strings.Replace(st.after, oldText, newText, 1)
+		}
+		st.seen = true
+		return
+	}
+	if st.before != "" || st.after != "" {
+		st.before = ensureTrailingNewline(st.before) + excerptSeparator
+		st.after = ensureTrailingNewline(st.after) + excerptSeparator
+	}
+	st.before += ensureTrailingNewline(oldText)
+	st.after += ensureTrailingNewline(newText)
+	st.seen = true
+	st.partial = true
+}
+
+func ensureTrailingNewline(s string) string {
+	if s == "" || strings.HasSuffix(s, "\n") {
+		return s
+	}
+	return s + "\n"
+}
+
+// truncateAtLine cuts s to at most n bytes, ending on a line boundary.
+func truncateAtLine(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	if i := strings.LastIndexByte(s[:n], '\n'); i >= 0 {
+		return s[:i+1]
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/sessionhuborg/plugin/go-cli/proto"
+)
+
+const (
+	maxCompactionObservations = 5
+	// compactionContextBudget covers connecting and fetching observations,
+	// inside the 5s timeout hooks.json gives session_start_context.
+	compactionContextBudget = 4 * time.Second
+)
+
+// compactionInteraction starts a "compaction" interaction from a
+// compact_boundary system entry. Its content is replaced by the summary
+// Claude Code writes right after the boundary.
+func compactionInteraction(entry map[string]any, ts string) *pb.InteractionData {
+	md := map[string]string{}
+	info := asMap(entry["compactMetadata"])
+	if trigger := asString(info["trigger"]); trigger != "" {
+		md["trigger"] = trigger
+	}
+	if n := toInt64(info["preTokens"]); n > 0 {
+		md["pre_tokens"] = strconv.FormatInt(n, 10)
+	}
+	if n := toInt64(info["postTokens"]); n > 0 {
+		md["post_tokens"] = strconv.FormatInt(n, 10)
+	}
+	return &pb.InteractionData{
+		Timestamp:       ts,
+		InteractionType: "compaction",
+		Content:         coalesce(strings.TrimSpace(asString(entry["content"])), "Conversation compacted"),
+		Metadata:        md,
+	}
+}
+
+func isCompactBoundary(entry map[string]any, typeName string) bool {
+	return typeName == "system" && asString(entry["subtype"]) == "compact_boundary"
+}
+
+// runHookSessionStartContext restores SessionHub context after Claude Code
+// compacts the conversation: the summary keeps the conversation but not what
+// hooks injected, so the project's recent observations are added again. The
+// project comes from the projects cache and the key is not validated
+// separately; there is no time for more round-trips within the hook timeout.
+// Other sources get no extra context.
+func runHookSessionStartContext() int {
+	input := readHookInput()
+	if input.Source != "compact" {
+		return emitEmptySessionStartContext()
+	}
+	deadline := time.Now().Add(compactionContextBudget)
+	cfg, _ := loadConfig()
+	apiKey := strings.TrimSpace(cfg.User.APIKey)
+	if apiKey == "" {
+		return emitEmptySessionStartContext()
+	}
+	entry, ok := loadProjectsCache().Paths[repositoryPath(hookProjectDir(input))]
+	if !ok || entry.ProjectID == "" {
+		return emitEmptySessionStartContext()
+	}
+
+	client, err := newAPIClient(cfg, apiKey, time.Until(deadline))
+	if err != nil {
+		return emitEmptySessionStartContext()
+	}
+	defer client.Close()
+	resp, err := client.GetProjectObservations(entry.ProjectID, maxCompactionObservations, time.Until(deadline))
+	if err != nil || len(resp.GetObservations()) == 0 {
+		return emitEmptySessionStartContext()
+	}
+
+	lines := []string{fmt.Sprintf("SessionHub context for project %s (restored after compaction):", entry.ProjectName)}
+	for _, obs := range resp.GetObservations() {
+		line := fmt.Sprintf("- [%s] %s", obs.GetType(), obs.GetTitle())
+		if subtitle := strings.TrimSpace(obs.GetSubtitle()); subtitle != "" {
+			line += ": " + subtitle
+		}
+		lines = append(lines, line)
+	}
+
+	output := hookOutput{}
+	output.HookSpecificOutput.HookEventName = "SessionStart"
+	output.HookSpecificOutput.AdditionalContext = strings.Join(lines, "\n")
+	_ = json.NewEncoder(os.Stdout).Encode(output)
+	return 0
+}
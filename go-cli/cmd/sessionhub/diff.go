@@ -0,0 +1,178 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxDiffCells bounds the LCS table; larger inputs are diffed as a full
+// replacement instead.
+const maxDiffCells = 4 << 20
+
+type diffOp struct {
+	kind byte // ' ', '-', '+'
+	text string
+}
+
+// unifiedDiff renders a line-based unified diff from a to b with the given
+// number of context lines. It returns "" when the inputs are identical.
+func unifiedDiff(fromName, toName, a, b string, context int) string {
+	if a == b {
+		return ""
+	}
+	ops := diffLines(splitDiffLines(a), splitDiffLines(b))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+
+	// Line numbers (1-based) in a and b at the start of each op.
+	aLine := make([]int, len(ops)+1)
+	bLine := make([]int, len(ops)+1)
+	aLine[0], bLine[0] = 1, 1
+	for i, op := range ops {
+		aLine[i+1], bLine[i+1] = aLine[i], bLine[i]
+		if op.kind != '+' {
+			aLine[i+1]++
+		}
+		if op.kind != '-' {
+			bLine[i+1]++
+		}
+	}
+
+	i := 0
+	for i < len(ops) {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		start := max(i-context, 0)
+		end := i
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			run := end
+			for run < len(ops) && ops[run].kind == ' ' {
+				run++
+			}
+			if run == len(ops) || run-end > 2*context {
+				break
+			}
+			end = run
+		}
+		stop := min(end+context, len(ops))
+
+		aCount, bCount := 0, 0
+		for _, op := range ops[start:stop] {
+			if op.kind != '+' {
+				aCount++
+			}
+			if op.kind != '-' {
+				bCount++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(aLine[start], aCount), hunkRange(bLine[start], bCount))
+		for _, op := range ops[start:stop] {
+			sb.WriteByte(op.kind)
+			sb.WriteString(op.text)
+			sb.WriteByte('\n')
+		}
+		i = stop
+	}
+	return sb.String()
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+func splitDiffLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+func diffLines(a, b []string) []diffOp {
+	// Trim the common prefix and suffix so the LCS table only covers the
+	// region that actually changed.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		ops = append(ops, diffOp{kind: ' ', text: line})
+	}
+	ops = append(ops, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{kind: ' ', text: line})
+	}
+	return ops
+}
+
+func diffMiddle(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	ops := make([]diffOp, 0, n+m)
+	if n == 0 || m == 0 || n*m > maxDiffCells {
+		for _, line := range a {
+			ops = append(ops, diffOp{kind: '-', text: line})
+		}
+		for _, line := range b {
+			ops = append(ops, diffOp{kind: '+', text: line})
+		}
+		return ops
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:].
+	lcs := make([][]int32, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{kind: ' ', text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{kind: '-', text: a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{kind: '+', text: b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{kind: '-', text: a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{kind: '+', text: b[j]})
+	}
+	return ops
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	maxSessionCommits = 50
+	// maxGitWalk bounds how far back the branch history is read.
+	maxGitWalk = 1000
+)
+
+var shortstatRegex = regexp.MustCompile(`(\d+) (files? changed|insertions?\(\+\)|deletions?\(-\))`)
+
+// sessionCommit is a commit authored on the session's branch while the
+// session was running.
+type sessionCommit struct {
+	SHA          string `json:"sha"`
+	Message      string `json:"message"`
+	AuthoredAt   string `json:"authoredAt"`
+	FilesChanged int    `json:"filesChanged"`
+	Insertions   int    `json:"insertions"`
+	Deletions    int    `json:"deletions"`
+}
+
+// sessionGitActivity ties a session to the repository history: the branch tip
+// when it started and ended, and the commits authored in between.
+type sessionGitActivity struct {
+	HeadStart string
+	HeadEnd   string
+	Commits   []sessionCommit
+}
+
+// collectGitActivity reads the last maxGitWalk commits of the session's branch
+// (HEAD when the transcript has none) in the checkout at repoPath. Everything
+// is decided by author date, which survives rebases and amends where the
+// committer date does not: branch tips are the newest commits authored before
+// the session's start and end times, so importing an old transcript gives the
+// same answer as capturing it live, and commits are those authored in between,
+// capped at maxSessionCommits.
+func collectGitActivity(repoPath string, parsed *parsedSession) sessionGitActivity {
+	var activity sessionGitActivity
+	start, startErr := time.Parse(time.RFC3339, parsed.StartTime)
+	end, endErr := time.Parse(time.RFC3339, parsed.EndTime)
+	if repoPath == "" || startErr != nil || endErr != nil {
+		return activity
+	}
+	// A branch that no longer exists cannot be traced; falling back to HEAD
+	// would credit the session with another branch's commits.
+	ref := coalesce(strings.TrimSpace(parsed.GitBranch), "HEAD")
+	if gitOutput(repoPath, "rev-parse", "--verify", "--quiet", ref) == "" {
+		return activity
+	}
+
+	log := gitOutput(repoPath, "log", "-n", strconv.Itoa(maxGitWalk),
+		"--format=%x1e%H%x1f%aI%x1f%s", "--shortstat", ref)
+	for _, record := range strings.Split(log, "\x1e") {
+		header, stat, _ := strings.Cut(record, "\n")
+		fields := strings.Split(header, "\x1f")
+		if len(fields) != 3 {
+			continue
+		}
+		authored, err := time.Parse(time.RFC3339, fields[1])
+		if err != nil || authored.After(end) {
+			continue
+		}
+		if activity.HeadEnd == "" {
+			activity.HeadEnd = fields[0]
+		}
+		if authored.Before(start) {
+			if activity.HeadStart == "" {
+				activity.HeadStart = fields[0]
+			}
+			continue
+		}
+		if len(activity.Commits) == maxSessionCommits {
+			continue
+		}
+		commit := sessionCommit{SHA: fields[0], Message: fields[2], AuthoredAt: authored.UTC().Format(time.RFC3339)}
+		for _, m := range shortstatRegex.FindAllStringSubmatch(stat, -1) {
+			n, _ := strconv.Atoi(m[1])
+			switch m[2][0] {
+			case 'f':
+				commit.FilesChanged = n
+			case 'i':
+				commit.Insertions = n
+			case 'd':
+				commit.Deletions = n
+			}
+		}
+		activity.Commits = append(activity.Commits, commit)
+	}
+	return activity
+}
+
+// addGitActivityMetadata records the branch tips as "git_head_start" and
+// "git_head_end", and the commits as "git_commits" (a JSON array, newest
+// first) with their number in "git_commit_count".
+func addGitActivityMetadata(md map[string]string, activity sessionGitActivity) {
+	if activity.HeadStart != "" {
+		md["git_head_start"] = activity.HeadStart
+	}
+	if activity.HeadEnd != "" {
+		md["git_head_end"] = activity.HeadEnd
+	}
+	if len(activity.Commits) == 0 {
+		return
+	}
+	if b, err := json.Marshal(activity.Commits); err == nil {
+		md["git_commits"] = string(b)
+		md["git_commit_count"] = strconv.Itoa(len(activity.Commits))
+	}
+}
+
+// gitOutput runs a git command in dir and returns its trimmed output, or ""
+// if it fails.
+func gitOutput(dir string, args ...string) string {
+	output, err := exec.Command("git", append([]string{"-C", dir}, args...)...).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
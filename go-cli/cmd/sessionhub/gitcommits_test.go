@@ -0,0 +1,40 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestCollectGitActivityUsesAuthorDate(t *testing.T) {
+	dir := t.TempDir()
+	git := func(authorDate, committerDate string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=t", "-c", "user.email=t@example.com"}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_DATE="+authorDate, "GIT_COMMITTER_DATE="+committerDate)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	commit := func(name, authorDate, committerDate string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		git(authorDate, committerDate, "add", name)
+		git(authorDate, committerDate, "commit", "-q", "-m", name)
+	}
+	git("", "", "init", "-q", "-b", "work")
+	commit("before", "2025-01-01T09:00:00Z", "2025-01-01T09:00:00Z")
+	// Rebased later: authored during the session, committed a day after.
+	commit("during", "2025-01-01T10:30:00Z", "2025-01-02T12:00:00Z")
+
+	activity := collectGitActivity(dir, &parsedSession{StartTime: "2025-01-01T10:00:00Z", EndTime: "2025-01-01T11:00:00Z", GitBranch: "work"})
+	if len(activity.Commits) != 1 || activity.Commits[0].Message != "during" {
+		t.Fatalf("commits = %+v, want the rebased commit", activity.Commits)
+	}
+	if activity.HeadStart == "" || activity.HeadEnd != activity.Commits[0].SHA {
+		t.Fatalf("heads = %s..%s", activity.HeadStart, activity.HeadEnd)
+	}
+}
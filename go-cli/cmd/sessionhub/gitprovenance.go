@@ -0,0 +1,261 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pb "github.com/sessionhuborg/plugin/go-cli/proto"
+)
+
+const (
+	sessionTrailerKey   = "SessionHub-Session"
+	defaultNotesRef     = "refs/notes/sessionhub"
+	hookManagedMarker   = "# sessionhub-managed"
+	chainedHookFileName = "prepare-commit-msg.local"
+)
+
+// prepareCommitMsgHook adds the trailer when the commit is made from a Claude
+// Code session, which the SessionStart hook marks by exporting
+// SESSIONHUB_SESSION_ID into the session's shell environment. A hook that was
+// there before install-hook --force is kept as prepare-commit-msg.local and
+// runs first.
+var prepareCommitMsgHook = `#!/bin/sh
+` + hookManagedMarker + ` (installed by "sessionhub git install-hook")
+hook_dir=$(dirname "$0")
+if [ -x "$hook_dir/` + chainedHookFileName + `" ]; then
+	"$hook_dir/` + chainedHookFileName + `" "$@" || exit $?
+fi
+[ -n "$SESSIONHUB_SESSION_ID" ] || exit 0
+case "$2" in
+	merge|squash) exit 0 ;;
+esac
+exec git interpret-trailers --in-place --if-exists addIfDifferent \
+	--trailer "` + sessionTrailerKey + `: $SESSIONHUB_SESSION_ID" "$1"
+`
+
+func runGit(args []string) int {
+	if len(args) < 1 {
+		fmt.Fprintln(os.Stderr, "usage: sessionhub git install-hook|notes [flags]")
+		return 2
+	}
+
+	switch args[0] {
+	case "install-hook":
+		return runGitInstallHook(args[1:])
+	case "notes":
+		return runGitNotes(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "unknown git subcommand: %s\n", args[0])
+		return 2
+	}
+}
+
+func runGitInstallHook(args []string) int {
+	fs := flag.NewFlagSet("git install-hook", flag.ContinueOnError)
+	repoPath := fs.String("path", "", "Repository path (default: current directory)")
+	force := fs.Bool("force", false, "Install even if a prepare-commit-msg hook exists; the existing hook is kept and run first")
+	jsonOutput := fs.Bool("json", false, "Emit JSON output")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	dir, err := resolveRepoArg(*repoPath)
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+	hooksDir := gitOutput(dir, "rev-parse", "--git-path", "hooks")
+	if hooksDir == "" {
+		return emitError(fmt.Errorf("not a git repository: %s", dir), *jsonOutput)
+	}
+	if !filepath.IsAbs(hooksDir) {
+		hooksDir = filepath.Join(dir, hooksDir)
+	}
+	if err := os.MkdirAll(hooksDir, 0o755); err != nil {
+		return emitError(err, *jsonOutput)
+	}
+
+	hookPath := filepath.Join(hooksDir, "prepare-commit-msg")
+	chained := ""
+	existing, readErr := os.ReadFile(hookPath)
+	switch {
+	case readErr == nil && strings.Contains(string(existing), hookManagedMarker):
+		// Reinstalling refreshes the script.
+	case readErr == nil:
+		if !*force {
+			return emitError(fmt.Errorf("%s already exists; rerun with --force to keep it as %s and install alongside it", hookPath, chainedHookFileName), *jsonOutput)
+		}
+		chained = filepath.Join(hooksDir, chainedHookFileName)
+		if err := os.Rename(hookPath, chained); err != nil {
+			return emitError(err, *jsonOutput)
+		}
+	case !errors.Is(readErr, os.ErrNotExist):
+		return emitError(readErr, *jsonOutput)
+	}
+
+	if err := os.WriteFile(hookPath, []byte(prepareCommitMsgHook), 0o755); err != nil {
+		return emitError(err, *jsonOutput)
+	}
+
+	payload := map[string]any{"success": true, "hookPath": hookPath, "trailer": sessionTrailerKey}
+	if chained != "" {
+		payload["chainedHook"] = chained
+	}
+	if *jsonOutput {
+		return emitJSONOrPretty(payload, true)
+	}
+	fmt.Printf("Installed %s\n", hookPath)
+	if chained != "" {
+		fmt.Printf("Existing hook kept as %s and run first\n", chained)
+	}
+	fmt.Printf("Commits made from Claude Code sessions will get a %q trailer\n", sessionTrailerKey+": <session-id>")
+	return 0
+}
+
+// runGitNotes attaches a git note to every local commit that captured
+// sessions linked to (see collectGitActivity), naming the session that
+// produced it. Notes go to a dedicated ref so they never mix with other notes;
+// commits that already carry a note for the session are left alone, so the
+// command can be re-run after every capture.
+func runGitNotes(args []string) int {
+	fs := flag.NewFlagSet("git notes", flag.ContinueOnError)
+	repoPath := fs.String("path", "", "Repository path (default: current directory)")
+	projectName := fs.String("project", "", "Project name (default: project for the repository)")
+	since := fs.String("since", "", "Only sessions started at or after this time (RFC 3339, YYYY-MM-DD, or a duration like 30d)")
+	notesRef := fs.String("ref", defaultNotesRef, "Notes ref to write")
+	dryRun := fs.Bool("dry-run", false, "Show the notes that would be added without writing them")
+	apiKeyOverride := fs.String("api-key", "", "API key override")
+	jsonOutput := fs.Bool("json", false, "Emit JSON output")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	dir, err := resolveRepoArg(*repoPath)
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+	if gitOutput(dir, "rev-parse", "--git-dir") == "" {
+		return emitError(fmt.Errorf("not a git repository: %s", dir), *jsonOutput)
+	}
+	req := &pb.ListSessionsRequest{}
+	if strings.TrimSpace(*since) != "" {
+		t, parseErr := parseTimeBound(*since, time.Now(), false)
+		if parseErr != nil {
+			return emitError(fmt.Errorf("invalid --since: %w", parseErr), *jsonOutput)
+		}
+		req.StartAfter = stringPtr(t.UTC().Format(time.RFC3339))
+	}
+
+	_, client, _, err := initializeAuthenticatedClient(*apiKeyOverride, 15*time.Second)
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+	defer client.Close()
+
+	projects, err := client.GetProjects(15 * time.Second)
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+	var project *pb.Project
+	if name := strings.TrimSpace(*projectName); name != "" {
+		project = findProjectByName(projects, name)
+	} else {
+		project = findProjectForPath(projects, dir)
+	}
+	if project == nil {
+		return emitError(fmt.Errorf("project not found for %s (use --project)", dir), *jsonOutput)
+	}
+	req.ProjectId = stringPtr(project.GetId())
+
+	added := make([]map[string]any, 0)
+	skipped, missing, sessionsScanned := 0, 0, 0
+	for {
+		req.Limit = 100
+		resp, listErr := client.ListSessions(req, 20*time.Second)
+		if listErr != nil {
+			return emitError(listErr, *jsonOutput)
+		}
+		for _, s := range resp.GetSessions() {
+			sessionsScanned++
+			var commits []sessionCommit
+			if json.Unmarshal([]byte(s.GetMetadata()["git_commits"]), &commits) != nil {
+				continue
+			}
+			note := sessionNote(s)
+			for _, c := range commits {
+				if gitOutput(dir, "cat-file", "-t", c.SHA) != "commit" {
+					missing++
+					continue
+				}
+				if strings.Contains(gitOutput(dir, "notes", "--ref", *notesRef, "show", c.SHA)+"\n", "SessionHub-Session-Id: "+s.GetId()+"\n") {
+					skipped++
+					continue
+				}
+				if !*dryRun {
+					if out, addErr := exec.Command("git", "-C", dir, "notes", "--ref", *notesRef, "append", "-m", note, c.SHA).CombinedOutput(); addErr != nil {
+						return emitError(fmt.Errorf("git notes append %s: %s", c.SHA, strings.TrimSpace(string(out))), *jsonOutput)
+					}
+				}
+				added = append(added, map[string]any{"sha": c.SHA, "message": c.Message, "sessionId": s.GetId(), "sessionName": s.GetName()})
+			}
+		}
+		if resp.GetNextPageToken() == "" || len(resp.GetSessions()) == 0 {
+			break
+		}
+		req.PageToken = stringPtr(resp.GetNextPageToken())
+	}
+
+	payload := map[string]any{
+		"success":         true,
+		"dryRun":          *dryRun,
+		"notesRef":        *notesRef,
+		"projectName":     project.GetName(),
+		"sessionsScanned": sessionsScanned,
+		"notesAdded":      added,
+		"alreadyNoted":    skipped,
+		"missingCommits":  missing,
+	}
+	if *jsonOutput {
+		return emitJSONOrPretty(payload, true)
+	}
+	verb := "Added"
+	if *dryRun {
+		verb = "Would add"
+	}
+	fmt.Printf("%s %d note%s to %s (%d already noted, %d commit%s not in this clone)\n",
+		verb, len(added), plural(len(added)), *notesRef, skipped, missing, plural(missing))
+	for _, a := range added {
+		fmt.Printf("  %.12s %s -> %s\n", a["sha"], a["message"], a["sessionId"])
+	}
+	if len(added) > 0 && !*dryRun {
+		fmt.Printf("View them with: git log --notes=%s\n", strings.TrimPrefix(*notesRef, "refs/notes/"))
+	}
+	return 0
+}
+
+// sessionNote is the note text for one session. SessionHub-Session holds the
+// Claude Code session ID, matching the trailer written by the commit hook.
+func sessionNote(s *pb.Session) string {
+	lines := make([]string, 0, 3)
+	if original := s.GetMetadata()["original_session_id"]; original != "" {
+		lines = append(lines, sessionTrailerKey+": "+original)
+	}
+	lines = append(lines, "SessionHub-Session-Id: "+s.GetId())
+	if name := strings.TrimSpace(s.GetName()); name != "" {
+		lines = append(lines, "SessionHub-Session-Name: "+name)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func resolveRepoArg(path string) (string, error) {
+	if p := strings.TrimSpace(path); p != "" {
+		return filepath.Abs(p)
+	}
+	return os.Getwd()
+}
@@ -0,0 +1,395 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/sessionhuborg/plugin/go-cli/proto"
+)
+
+const maxImportWorkers = 16
+
+// importManifest remembers which transcripts of a project import-all has
+// already uploaded, so an interrupted or repeated import only sends new or
+// changed files. Entries are keyed by transcript path and are only valid while
+// the file's size and modification time are unchanged.
+type importManifest struct {
+	ProjectPath string                         `json:"projectPath"`
+	ProjectName string                         `json:"projectName"`
+	Files       map[string]importManifestEntry `json:"files"`
+
+	path string
+	mu   sync.Mutex
+}
+
+type importManifestEntry struct {
+	Size       int64  `json:"size"`
+	ModTime    string `json:"modTime"`
+	SessionID  string `json:"sessionId"`
+	ImportedAt string `json:"importedAt"`
+}
+
+func importManifestPath(projectPath string) string {
+	sum := sha256.Sum256([]byte(projectPath))
+	name := coalesce(slugify(filepath.Base(projectPath)), "project")
+	return filepath.Join(filepath.Dir(configPath()), "imports", fmt.Sprintf("%s-%s.json", name, hex.EncodeToString(sum[:4])))
+}
+
+// loadImportManifest reads a project's manifest; a missing or unreadable one
+// yields an empty manifest.
+func loadImportManifest(projectPath, projectName string) *importManifest {
+	m := &importManifest{path: importManifestPath(projectPath)}
+	if data, err := os.ReadFile(m.path); err == nil {
+		_ = json.Unmarshal(data, m)
+	}
+	m.ProjectPath = projectPath
+	m.ProjectName = projectName
+	if m.Files == nil {
+		m.Files = map[string]importManifestEntry{}
+	}
+	return m
+}
+
+// lookup returns the manifest entry for a file and whether the file is
+// unchanged since it was recorded.
+func (m *importManifest) lookup(file string, size int64, modTime time.Time) (importManifestEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.Files[file]
+	if !ok {
+		return entry, false
+	}
+	return entry, entry.Size == size && entry.ModTime == modTime.UTC().Format(time.RFC3339Nano)
+}
+
+// record marks a file as imported and saves the manifest right away, so a
+// crash later in the run does not lose it.
+func (m *importManifest) record(file string, size int64, modTime time.Time, sessionID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Files[file] = importManifestEntry{
+		Size:       size,
+		ModTime:    modTime.UTC().Format(time.RFC3339Nano),
+		SessionID:  sessionID,
+		ImportedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	if err := os.MkdirAll(filepath.Dir(m.path), 0o700); err != nil {
+		return err
+	}
+	payload, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := fmt.Sprintf("%s.%d.tmp", m.path, os.Getpid())
+	if err := os.WriteFile(tmp, payload, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.path)
+}
+
+// importJob is one transcript to upload into a SessionHub project.
+type importJob struct {
+	File        string
+	Size        int64
+	ModTime     time.Time
+	ProjectName string
+	ProjectPath string
+	Manifest    *importManifest
+	StartTime   time.Time
+	Transcript  transcriptOptions
+	Pricing     pricingTable
+}
+
+// importFilter narrows import-all to the transcripts worth spending quota on.
+// Zero values disable a criterion; Branch may be a glob such as "feat/*".
+type importFilter struct {
+	Since           time.Time
+	Until           time.Time
+	MinInteractions int
+	Branch          string
+}
+
+func (f importFilter) active() bool {
+	return !f.Since.IsZero() || !f.Until.IsZero() || f.MinInteractions > 0 || f.Branch != ""
+}
+
+func (f importFilter) matches(parsed *parsedSession, start time.Time) bool {
+	if !f.Since.IsZero() && start.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !start.Before(f.Until) {
+		return false
+	}
+	if f.MinInteractions > 0 && len(parsed.Interactions) < f.MinInteractions {
+		return false
+	}
+	if f.Branch != "" {
+		if ok, _ := path.Match(f.Branch, parsed.GitBranch); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// filterImportJobs drops jobs that do not match the filter and, with
+// newestFirst, orders the rest by session start time, newest first. Both need
+// the transcript parsed, so this is skipped entirely when neither applies.
+// Transcripts that fail to parse are kept so the import reports the error.
+func filterImportJobs(jobs []importJob, filter importFilter, newestFirst bool) ([]importJob, int) {
+	if !filter.active() && !newestFirst {
+		return jobs, 0
+	}
+	kept := make([]importJob, 0, len(jobs))
+	for _, job := range jobs {
+		parsed, err := parseTranscriptFile(job.File, 0, transcriptOptions{})
+		if err != nil {
+			kept = append(kept, job)
+			continue
+		}
+		start, _ := time.Parse(time.RFC3339, parsed.StartTime)
+		if !filter.matches(parsed, start) {
+			continue
+		}
+		job.StartTime = start
+		kept = append(kept, job)
+	}
+	if newestFirst {
+		sortImportJobsNewestFirst(kept)
+	}
+	return kept, len(jobs) - len(kept)
+}
+
+func sortImportJobsNewestFirst(jobs []importJob) {
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].StartTime.After(jobs[j].StartTime) })
+}
+
+type importOutcome struct {
+	Job         importJob
+	SessionID   string
+	SessionName string
+	SessionType string
+	Mapped      bool
+	Err         error
+}
+
+func (o importOutcome) payload() map[string]any {
+	result := map[string]any{"file": filepath.Base(o.Job.File), "success": o.Err == nil}
+	if o.Err != nil {
+		result["error"] = o.Err.Error()
+		return result
+	}
+	result["sessionId"] = o.SessionID
+	result["sessionName"] = o.SessionName
+	result["sessionType"] = o.SessionType
+	if o.Mapped {
+		result["projectName"] = o.Job.ProjectName
+	}
+	return result
+}
+
+// runImportJobs uploads jobs with a pool of workers, sending sessions that
+// match a monorepo mapping to the mapped project. progress is called from
+// the calling goroutine once per finished job, in completion order; the
+// returned outcomes are in job order.
+func runImportJobs(client *apiClient, jobs []importJob, workers int, typeOverride string, mapped *mappedProjects, progress func(done, total int, o importOutcome)) []importOutcome {
+	workers = max(1, min(workers, maxImportWorkers))
+	type indexed struct {
+		i int
+		o importOutcome
+	}
+	queue := make(chan int)
+	finished := make(chan indexed)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				finished <- indexed{i, importTranscript(client, jobs[i], typeOverride, mapped)}
+			}
+		}()
+	}
+	go func() {
+		for i := range jobs {
+			queue <- i
+		}
+		close(queue)
+		wg.Wait()
+		close(finished)
+	}()
+
+	outcomes := make([]importOutcome, len(jobs))
+	done := 0
+	for r := range finished {
+		outcomes[r.i] = r.o
+		done++
+		if progress != nil {
+			progress(done, len(jobs), r.o)
+		}
+	}
+	return outcomes
+}
+
+func importTranscript(client *apiClient, job importJob, typeOverride string, mapped *mappedProjects) importOutcome {
+	outcome := importOutcome{Job: job}
+	parsed, err := parseTranscriptFile(job.File, 0, job.Transcript)
+	if err != nil {
+		outcome.Err = err
+		return outcome
+	}
+	project, err := mapped.resolve(job.ProjectPath, parsed)
+	if err != nil {
+		outcome.Err = err
+		return outcome
+	}
+	if project != nil && project.GetName() != job.ProjectName {
+		job.ProjectName = project.GetName()
+		outcome.Job.ProjectName = job.ProjectName
+		outcome.Mapped = true
+	}
+
+	req := &pb.CreateSessionRequest{
+		ProjectName:       job.ProjectName,
+		ProjectPath:       stringPtr(repositoryPath(job.ProjectPath)),
+		StartTime:         parsed.StartTime,
+		EndTime:           optionalString(parsed.EndTime),
+		Name:              stringPtr(deriveSessionName(parsed)),
+		Type:              stringPtr(coalesce(typeOverride, classifySessionType(parsed))),
+		ToolName:          coalesce(parsed.ToolName, "claude-code"),
+		GitBranch:         optionalString(parsed.GitBranch),
+		InputTokens:       parsed.TotalInputTokens,
+		OutputTokens:      parsed.TotalOutputTokens,
+		CacheCreateTokens: parsed.TotalCacheCreateTokens,
+		CacheReadTokens:   parsed.TotalCacheReadTokens,
+		Interactions:      parsed.Interactions,
+		PlanSlug:          optionalString(parsed.PlanSlug),
+		FileChanges:       collectFileChanges(parsed),
+		Metadata:          transcriptMetadata(parsed, "cli_bulk"),
+	}
+	addWorktreeMetadata(req.Metadata, detectGitCheckout(job.ProjectPath))
+	addGitActivityMetadata(req.Metadata, collectGitActivity(job.ProjectPath, parsed))
+	addCostMetadata(req.Metadata, estimateCost(parsed.TokensByModel, job.Pricing))
+
+	resp, err := client.UpsertSession(req, importTimeout(job.Size))
+	if err != nil {
+		outcome.Err = err
+		return outcome
+	}
+	outcome.SessionID = resp.GetSessionId()
+	outcome.SessionName = req.GetName()
+	outcome.SessionType = req.GetType()
+	if job.Manifest != nil {
+		// The upload succeeded; failing to remember it only costs a
+		// re-upload next time.
+		_ = job.Manifest.record(job.File, job.Size, job.ModTime, outcome.SessionID)
+	}
+	return outcome
+}
+
+// importTimeout gives large transcripts more time: 60s plus 5s per MiB, up to
+// five minutes.
+func importTimeout(size int64) time.Duration {
+	return min(60*time.Second+time.Duration(size>>20)*5*time.Second, 5*time.Minute)
+}
+
+// importTarget is a project directory to import transcripts from. Name is an
+// explicit project name, or empty to resolve it from the checkout.
+type importTarget struct {
+	Name  string
+	Path  string
+	Files []string
+}
+
+// discoverImportTargets lists every project under ~/.claude/projects. The
+// directory names there are mangled project paths that cannot be reversed
+// reliably, so each project's path is recovered from the cwd recorded in its
+// transcripts. Directories whose path cannot be recovered are reported
+// rather than imported.
+func discoverImportTargets() ([]importTarget, []map[string]any, error) {
+	root := claudeProjectsRoot()
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	targets := make([]importTarget, 0, len(entries))
+	unresolved := make([]map[string]any, 0)
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		dir := filepath.Join(root, e.Name())
+		files, listErr := listTranscriptFilesInDir(dir)
+		if listErr != nil || len(files) == 0 {
+			continue
+		}
+		projectPath := recoverProjectPath(e.Name(), files)
+		if projectPath == "" {
+			unresolved = append(unresolved, map[string]any{"directory": dir, "error": "could not recover the project path from its transcripts"})
+			continue
+		}
+		targets = append(targets, importTarget{Path: projectPath, Files: files})
+	}
+	return targets, unresolved, nil
+}
+
+// recoverProjectPath picks the cwd that Claude Code was started in for a
+// project directory. A cwd that mangles back to the directory name wins;
+// otherwise the most common cwd is used, since sessions can cd elsewhere.
+func recoverProjectPath(dirName string, files []string) string {
+	counts := map[string]int{}
+	for _, file := range files {
+		for _, cwd := range transcriptCwds(file) {
+			if filepath.Base(claudeProjectDir(cwd)) == dirName {
+				return cwd
+			}
+			counts[cwd]++
+		}
+	}
+	best, bestCount := "", 0
+	for cwd, n := range counts {
+		if n > bestCount || (n == bestCount && cwd < best) {
+			best, bestCount = cwd, n
+		}
+	}
+	return best
+}
+
+// transcriptCwds returns the distinct cwd values in a transcript, reading at
+// most the first 200 entries.
+func transcriptCwds(file string) []string {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	seen := map[string]bool{}
+	cwds := make([]string, 0, 1)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for i := 0; i < 200 && scanner.Scan(); i++ {
+		var entry struct {
+			Cwd string `json:"cwd"`
+		}
+		if json.Unmarshal(scanner.Bytes(), &entry) != nil || entry.Cwd == "" || seen[entry.Cwd] {
+			continue
+		}
+		seen[entry.Cwd] = true
+		cwds = append(cwds, entry.Cwd)
+	}
+	return cwds
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	maxSessionLanguages = 5
+	minLanguageShare    = 0.05
+)
+
+var (
+	// languageAliases lists, per language, the file extensions (without the
+	// dot), special file names and code fence tags that identify it. Data and
+	// prose formats such as JSON, YAML and Markdown are left out on purpose.
+	languageAliases = map[string][]string{
+		"Go":               {"go", "golang"},
+		"Python":           {"py", "pyi", "python", "python3", "ipynb"},
+		"JavaScript":       {"js", "mjs", "cjs", "jsx", "javascript"},
+		"TypeScript":       {"ts", "tsx", "mts", "cts", "typescript"},
+		"Ruby":             {"rb", "ruby", "rake"},
+		"Rust":             {"rs", "rust"},
+		"Java":             {"java"},
+		"Kotlin":           {"kt", "kts", "kotlin"},
+		"Swift":            {"swift"},
+		"C":                {"c", "h"},
+		"C++":              {"cpp", "cc", "cxx", "hpp", "hh", "c++"},
+		"C#":               {"cs", "csharp"},
+		"PHP":              {"php"},
+		"Scala":            {"scala"},
+		"Shell":            {"sh", "bash", "zsh", "shell", "console"},
+		"SQL":              {"sql"},
+		"HTML":             {"html", "htm"},
+		"CSS":              {"css", "scss", "sass", "less"},
+		"Vue":              {"vue"},
+		"Svelte":           {"svelte"},
+		"Lua":              {"lua"},
+		"Dart":             {"dart"},
+		"Elixir":           {"ex", "exs", "elixir"},
+		"Erlang":           {"erl"},
+		"Haskell":          {"hs", "haskell"},
+		"OCaml":            {"ml", "ocaml"},
+		"R":                {"r"},
+		"Julia":            {"jl", "julia"},
+		"Zig":              {"zig"},
+		"HCL":              {"tf", "hcl"},
+		"Protocol Buffers": {"proto", "protobuf"},
+		"Dockerfile":       {"dockerfile"},
+		"Makefile":         {"makefile", "make"},
+	}
+	languageNames = func() map[string]string {
+		names := map[string]string{}
+		for lang, aliases := range languageAliases {
+			for _, alias := range aliases {
+				names[alias] = lang
+			}
+		}
+		return names
+	}()
+
+	codeFenceLangRegex = regexp.MustCompile("(?m)^\\s*```+\\s*([A-Za-z0-9_+#-]+)[^\\n]*\\n((?s:.*?))^\\s*```")
+)
+
+type languageShare struct {
+	Name  string  `json:"name"`
+	Share float64 `json:"share"`
+}
+
+// detectLanguages estimates which languages a session worked in. Edits and
+// writes count by the number of lines they produce, reads count as one line,
+// and code fences in responses count at half weight since they are often
+// illustrations rather than changes. Languages under minLanguageShare are
+// dropped.
+func detectLanguages(parsed *parsedSession) []languageShare {
+	weights := map[string]float64{}
+	addFile := func(path string, lines float64) {
+		if lang := languageForPath(path); lang != "" {
+			weights[lang] += lines
+		}
+	}
+
+	for _, use := range parsed.ToolUses {
+		switch use.Name {
+		case "Edit":
+			addFile(asString(use.Input["file_path"]), countLines(asString(use.Input["new_string"])))
+		case "MultiEdit":
+			lines := 0.0
+			if edits, ok := use.Input["edits"].([]any); ok {
+				for _, e := range edits {
+					lines += countLines(asString(asMap(e)["new_string"]))
+				}
+			}
+			addFile(asString(use.Input["file_path"]), lines)
+		case "Write":
+			addFile(asString(use.Input["file_path"]), countLines(asString(use.Input["content"])))
+		case "NotebookEdit":
+			addFile(asString(use.Input["notebook_path"]), countLines(asString(use.Input["new_source"])))
+		case "Read":
+			addFile(asString(use.Input["file_path"]), 1)
+		}
+	}
+
+	for _, it := range parsed.Interactions {
+		if it.GetInteractionType() != "response" {
+			continue
+		}
+		for _, m := range codeFenceLangRegex.FindAllStringSubmatch(it.GetContent(), -1) {
+			if lang := languageNames[strings.ToLower(m[1])]; lang != "" {
+				weights[lang] += 0.5 * countLines(m[2])
+			}
+		}
+	}
+
+	total := 0.0
+	for _, w := range weights {
+		total += w
+	}
+	if total == 0 {
+		return nil
+	}
+	shares := make([]languageShare, 0, len(weights))
+	for name, w := range weights {
+		if share := w / total; share >= minLanguageShare {
+			shares = append(shares, languageShare{Name: name, Share: float64(int(share*1000+0.5)) / 1000})
+		}
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].Share != shares[j].Share {
+			return shares[i].Share > shares[j].Share
+		}
+		return shares[i].Name < shares[j].Name
+	})
+	if len(shares) > maxSessionLanguages {
+		shares = shares[:maxSessionLanguages]
+	}
+	return shares
+}
+
+func languageForPath(path string) string {
+	if path == "" {
+		return ""
+	}
+	base := strings.ToLower(filepath.Base(path))
+	if lang, ok := languageNames[base]; ok {
+		return lang
+	}
+	if strings.HasPrefix(base, "dockerfile") {
+		return "Dockerfile"
+	}
+	return languageNames[strings.TrimPrefix(filepath.Ext(base), ".")]
+}
+
+func countLines(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	return float64(strings.Count(strings.TrimSuffix(s, "\n"), "\n") + 1)
+}
+
+// addLanguageMetadata records detected languages as session metadata:
+// "languages" (comma-separated, most-used first), "primary_language" and
+// "language_breakdown" (a JSON object of language to share).
+func addLanguageMetadata(md map[string]string, langs []languageShare) {
+	if len(langs) == 0 {
+		return
+	}
+	names := make([]string, 0, len(langs))
+	breakdown := make(map[string]float64, len(langs))
+	for _, l := range langs {
+		names = append(names, l.Name)
+		breakdown[l.Name] = l.Share
+	}
+	md["languages"] = strings.Join(names, ",")
+	md["primary_language"] = names[0]
+	if b, err := json.Marshal(breakdown); err == nil {
+		md["language_breakdown"] = string(b)
+	}
+}
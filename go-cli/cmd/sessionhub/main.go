@@ -9,13 +9,13 @@ import (
 	"flag"
 	"fmt"
 	"io"
-	"io/fs"
 	"net"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -34,6 +34,16 @@ type config struct {
 	} `json:"user"`
 	BackendGRPCURL string `json:"backendGrpcUrl"`
 	GRPCUseTLS     *bool  `json:"grpcUseTls"`
+	Skills         struct {
+		AutoSync            bool `json:"autoSync,omitempty"`
+		SyncIntervalMinutes int  `json:"syncIntervalMinutes,omitempty"`
+	} `json:"skills"`
+	Capture struct {
+		IncludeThinking  bool `json:"includeThinking,omitempty"`
+		MaxThinkingChars int  `json:"maxThinkingChars,omitempty"`
+	} `json:"capture"`
+	ProjectMappings []projectMapping `json:"projectMappings,omitempty"`
+	Pricing         pricingTable     `json:"pricing,omitempty"`
 }
 
 type healthResult struct {
@@ -51,6 +61,7 @@ type healthResult struct {
 type hookInput struct {
 	SessionID string `json:"session_id"`
 	Cwd       string `json:"cwd"`
+	Source    string `json:"source"`
 }
 
 type hookOutput struct {
@@ -79,6 +90,26 @@ type parsedSession struct {
 	TotalCacheCreateTokens int64
 	TotalCacheReadTokens   int64
 	PlanSlug               string
+	FirstPrompt            string
+	Summary                string
+	ToolUses               []transcriptToolUse
+	Compactions            int
+	TokensByModel          map[string]tokenUsage
+}
+
+// transcriptToolUse is a tool_use block from an assistant message, with its
+// input kept for naming and classification. Failed, Created and OriginalFile
+// come from the matching tool result: Created is set when a Write made a new
+// file, and OriginalFile is the file's content before the call when Claude
+// Code recorded it.
+type transcriptToolUse struct {
+	ID           string
+	Name         string
+	Input        map[string]any
+	Timestamp    string
+	Failed       bool
+	Created      bool
+	OriginalFile *string
 }
 
 type lastSessionInfo struct {
@@ -118,6 +149,12 @@ func main() {
 		os.Exit(runSyncSkills(os.Args[2:]))
 	case "push-skill":
 		os.Exit(runPushSkill(os.Args[2:]))
+	case "skill":
+		os.Exit(runSkill(os.Args[2:]))
+	case "sessions":
+		os.Exit(runSessions(os.Args[2:]))
+	case "git":
+		os.Exit(runGit(os.Args[2:]))
 	case "hook":
 		os.Exit(runHook(os.Args[2:]))
 	default:
@@ -133,14 +170,21 @@ func printUsage() {
 	fmt.Println("Usage:")
 	fmt.Println("  sessionhub setup --api-key <key>")
 	fmt.Println("  sessionhub health [--json]")
-	fmt.Println("  sessionhub capture [--project <name>] [--session <name>] [--transcript <path>] [--project-path <path>] [--session-id <id>] [--last <n>] [--json]")
-	fmt.Println("  sessionhub import-all [--path <path>] [--project <name>] [--json]")
+	fmt.Println("  sessionhub capture [--project <name>] [--session <name>] [--transcript <path>] [--project-path <path>] [--session-id <id>] [--last <n>] [--type <type>] [--json]")
+	fmt.Println("  sessionhub import-all [--path <path>] [--project <name>] [--type <type>] [--workers <n>] [--force] [--since <when>] [--until <when>] [--min-interactions <n>] [--branch <glob>] [--newest-first] [--all-projects] [--json]")
 	fmt.Println("  sessionhub observations [--project <name>] [--session-id <id>] [--limit <n>] [--json]")
-	fmt.Println("  sessionhub sync-skills [--team <id>] [--project <id>] [--scope <team|project>] [--json]")
-	fmt.Println("  sessionhub push-skill --file <path> | --dir <path> [--team <id>] [--title <title>] [--category <cat>] [--tags a,b] [--summary <s>] [--json]")
+	fmt.Println("  sessionhub sync-skills [--team <id>] [--project <id>] [--scope <team|project>] [--on-conflict <skip|backup|remote>] [--force] [--dry-run] [--watch [--interval <dur>]] [--json]")
+	fmt.Println("  sessionhub push-skill --file <path> | --dir <path> [--team <id>] [--title <title>] [--category <cat>] [--tags a,b] [--summary <s>] [--slug <slug> | --new] [--skip-lint] [--json]")
+	fmt.Println("  sessionhub skill lint --file <path> | --dir <path> [--json]")
+	fmt.Println("  sessionhub sessions list [--project <name> | --all-projects] [--branch <name>] [--since <when>] [--until <when>] [--limit <n>] [--json]")
+	fmt.Println("  sessionhub sessions show [<id>] [--json]")
+	fmt.Println("  sessionhub sessions rename <id> <name> | retype <id> <type> | tag <id> <a,b> | tag <id> --clear | close [<id>] [--at <time>] [--json]")
+	fmt.Println("  sessionhub git install-hook [--path <repo>] [--force] [--json]")
+	fmt.Println("  sessionhub git notes [--path <repo>] [--project <name>] [--since <when>] [--ref <notes-ref>] [--dry-run] [--json]")
 	fmt.Println("  sessionhub hook session-start")
 	fmt.Println("  sessionhub hook session-start-context")
 	fmt.Println("  sessionhub hook session-start-clear-capture")
+	fmt.Println("  sessionhub hook session-start-sync-skills")
 	fmt.Println("  sessionhub hook session-end")
 }
 
@@ -260,12 +304,17 @@ func runCapture(args []string) int {
 	apiKeyOverride := fs.String("api-key", "", "API key override")
 	projectPath := fs.String("project-path", "", "Project path")
 	sessionID := fs.String("session-id", "", "Session ID")
+	sessionType := fs.String("type", "", "Session type: feature, bugfix, refactor, exploration or debugging (default: detected)")
 	jsonOutput := fs.Bool("json", false, "Emit JSON output")
 	if err := fs.Parse(args); err != nil {
 		return 2
 	}
+	typeOverride := strings.ToLower(strings.TrimSpace(*sessionType))
+	if typeOverride != "" && !isSessionType(typeOverride) {
+		return emitError(fmt.Errorf("invalid --type %q (expected one of: %s)", typeOverride, strings.Join(sessionTypes, ", ")), *jsonOutput)
+	}
 
-	_, client, user, err := initializeAuthenticatedClient(*apiKeyOverride, 15*time.Second)
+	cfg, client, user, err := initializeAuthenticatedClient(*apiKeyOverride, 15*time.Second)
 	if err != nil {
 		return emitError(err, *jsonOutput)
 	}
@@ -294,32 +343,40 @@ func runCapture(args []string) int {
 		resolvedTranscript = found
 	}
 
-	parsed, parseErr := parseTranscriptFile(resolvedTranscript, *lastExchanges)
+	parsed, parseErr := parseTranscriptFile(resolvedTranscript, *lastExchanges, transcriptOptionsFromConfig(cfg))
 	if parseErr != nil {
 		return emitError(parseErr, *jsonOutput)
 	}
 
-	finalProjectName := strings.TrimSpace(*projectName)
-	if finalProjectName == "" {
-		finalProjectName = filepath.Base(resolvedProjectPath)
+	// An explicit --project wins over monorepo mappings.
+	var project *pb.Project
+	if strings.TrimSpace(*projectName) == "" {
+		project, err = newMappedProjects(client, cfg.ProjectMappings).resolve(resolvedProjectPath, parsed)
+		if err != nil {
+			return emitError(err, *jsonOutput)
+		}
 	}
-
-	project, err := ensureProject(client, finalProjectName, resolvedProjectPath, parsed.GitBranch)
-	if err != nil {
-		return emitError(err, *jsonOutput)
+	if project == nil {
+		project, err = ensureProject(client, resolvedProjectPath, strings.TrimSpace(*projectName))
+		if err != nil {
+			return emitError(err, *jsonOutput)
+		}
 	}
+	finalProjectName := project.GetName()
 
 	finalSessionName := strings.TrimSpace(*sessionName)
 	if finalSessionName == "" {
-		finalSessionName = "Imported Session - " + time.Now().Format(time.RFC3339)
+		finalSessionName = deriveSessionName(parsed)
 	}
+	finalSessionType := coalesce(typeOverride, classifySessionType(parsed))
 
 	req := &pb.CreateSessionRequest{
 		ProjectName:       project.GetName(),
-		ProjectPath:       stringPtr(resolvedProjectPath),
+		ProjectPath:       stringPtr(repositoryPath(resolvedProjectPath)),
 		StartTime:         parsed.StartTime,
 		EndTime:           optionalString(parsed.EndTime),
 		Name:              stringPtr(finalSessionName),
+		Type:              stringPtr(finalSessionType),
 		ToolName:          coalesce(parsed.ToolName, "claude-code"),
 		GitBranch:         optionalString(parsed.GitBranch),
 		InputTokens:       parsed.TotalInputTokens,
@@ -328,11 +385,14 @@ func runCapture(args []string) int {
 		CacheReadTokens:   parsed.TotalCacheReadTokens,
 		Interactions:      parsed.Interactions,
 		PlanSlug:          optionalString(parsed.PlanSlug),
-		Metadata: map[string]string{
-			"import_source":       "cli",
-			"original_session_id": parsed.SessionID,
-		},
+		FileChanges:       collectFileChanges(parsed),
+		Metadata:          transcriptMetadata(parsed, "cli"),
 	}
+	addWorktreeMetadata(req.Metadata, detectGitCheckout(resolvedProjectPath))
+	gitActivity := collectGitActivity(resolvedProjectPath, parsed)
+	addGitActivityMetadata(req.Metadata, gitActivity)
+	cost := estimateCost(parsed.TokensByModel, pricingTableFromConfig(cfg))
+	addCostMetadata(req.Metadata, cost)
 
 	result, err := client.UpsertSession(req, 60*time.Second)
 	if err != nil {
@@ -346,6 +406,11 @@ func runCapture(args []string) int {
 		CapturedAt:  time.Now().UTC().Format(time.RFC3339),
 	})
 
+	linesAdded, linesRemoved := 0, 0
+	for _, fc := range req.GetFileChanges() {
+		linesAdded += int(fc.GetLinesAdded())
+		linesRemoved += int(fc.GetLinesRemoved())
+	}
 	payload := map[string]any{
 		"success":               true,
 		"sessionId":             result.GetSessionId(),
@@ -355,11 +420,19 @@ func runCapture(args []string) int {
 		"observationsTriggered": result.GetObservationsTriggered(),
 		"projectName":           finalProjectName,
 		"sessionName":           finalSessionName,
+		"sessionType":           finalSessionType,
+		"languages":             req.GetMetadata()["languages"],
+		"gitCommitCount":        len(gitActivity.Commits),
+		"filesChanged":          len(req.GetFileChanges()),
+		"linesAdded":            linesAdded,
+		"linesRemoved":          linesRemoved,
 		"transcriptFile":        filepath.Base(resolvedTranscript),
 		"totalInputTokens":      parsed.TotalInputTokens,
 		"totalOutputTokens":     parsed.TotalOutputTokens,
 		"cacheCreateTokens":     parsed.TotalCacheCreateTokens,
 		"cacheReadTokens":       parsed.TotalCacheReadTokens,
+		"tokensByModel":         parsed.TokensByModel,
+		"estimatedCost":         cost,
 	}
 	return emitJSONOrPretty(payload, *jsonOutput)
 }
@@ -368,51 +441,142 @@ func runImportAll(args []string) int {
 	fs := flag.NewFlagSet("import-all", flag.ContinueOnError)
 	projectName := fs.String("project", "", "Project name")
 	projectPath := fs.String("path", "", "Project path")
+	sessionType := fs.String("type", "", "Session type for every imported session (default: detected per session)")
+	workers := fs.Int("workers", 4, "Number of transcripts to upload in parallel")
+	force := fs.Bool("force", false, "Re-import transcripts that were already imported and have not changed")
+	since := fs.String("since", "", "Only sessions started at or after this time (RFC 3339, YYYY-MM-DD, or a duration like 30d)")
+	until := fs.String("until", "", "Only sessions started before this time (RFC 3339, YYYY-MM-DD inclusive, or a duration)")
+	minInteractions := fs.Int("min-interactions", 0, "Only sessions with at least this many interactions")
+	branch := fs.String("branch", "", "Only sessions on this git branch (glob patterns such as feat/* allowed)")
+	newestFirst := fs.Bool("newest-first", false, "Import the most recent sessions first, so quota goes to them")
+	allProjects := fs.Bool("all-projects", false, "Import every project under ~/.claude/projects")
 	apiKeyOverride := fs.String("api-key", "", "API key override")
-	jsonOutput := fs.Bool("json", false, "Emit JSON output")
+	jsonOutput := fs.Bool("json", false, "Emit JSON output (NDJSON progress events, then the summary)")
 	if err := fs.Parse(args); err != nil {
 		return 2
 	}
+	typeOverride := strings.ToLower(strings.TrimSpace(*sessionType))
+	if typeOverride != "" && !isSessionType(typeOverride) {
+		return emitError(fmt.Errorf("invalid --type %q (expected one of: %s)", typeOverride, strings.Join(sessionTypes, ", ")), *jsonOutput)
+	}
+	if *allProjects && (strings.TrimSpace(*projectPath) != "" || strings.TrimSpace(*projectName) != "") {
+		return emitError(errors.New("--all-projects cannot be combined with --path or --project"), *jsonOutput)
+	}
+	filter := importFilter{MinInteractions: *minInteractions, Branch: strings.TrimSpace(*branch)}
+	now := time.Now()
+	var err error
+	if strings.TrimSpace(*since) != "" {
+		if filter.Since, err = parseTimeBound(*since, now, false); err != nil {
+			return emitError(fmt.Errorf("invalid --since: %w", err), *jsonOutput)
+		}
+	}
+	if strings.TrimSpace(*until) != "" {
+		if filter.Until, err = parseTimeBound(*until, now, true); err != nil {
+			return emitError(fmt.Errorf("invalid --until: %w", err), *jsonOutput)
+		}
+	}
 
-	_, client, _, err := initializeAuthenticatedClient(*apiKeyOverride, 15*time.Second)
+	cfg, client, _, err := initializeAuthenticatedClient(*apiKeyOverride, 15*time.Second)
 	if err != nil {
 		return emitError(err, *jsonOutput)
 	}
 	defer client.Close()
 
-	resolvedProjectPath := strings.TrimSpace(*projectPath)
-	if resolvedProjectPath == "" {
-		if cwd, cwdErr := os.Getwd(); cwdErr == nil {
-			resolvedProjectPath = cwd
+	transcriptOpts := transcriptOptionsFromConfig(cfg)
+	pricing := pricingTableFromConfig(cfg)
+	var targets []importTarget
+	projectErrors := make([]map[string]any, 0)
+	unresolved := make([]map[string]any, 0)
+	if *allProjects {
+		targets, unresolved, err = discoverImportTargets()
+		if err != nil {
+			return emitError(err, *jsonOutput)
+		}
+		if len(targets) == 0 {
+			return emitError(errors.New("no Claude Code projects with transcripts found in ~/.claude/projects"), *jsonOutput)
+		}
+	} else {
+		resolvedProjectPath := strings.TrimSpace(*projectPath)
+		if resolvedProjectPath == "" {
+			if cwd, cwdErr := os.Getwd(); cwdErr == nil {
+				resolvedProjectPath = cwd
+			}
+		}
+		if resolvedProjectPath == "" {
+			return emitError(errors.New("could not resolve project path"), *jsonOutput)
 		}
-	}
-	if resolvedProjectPath == "" {
-		return emitError(errors.New("could not resolve project path"), *jsonOutput)
-	}
 
-	resolvedProjectName := strings.TrimSpace(*projectName)
-	if resolvedProjectName == "" {
-		resolvedProjectName = filepath.Base(resolvedProjectPath)
+		files, listErr := listTranscriptFiles(resolvedProjectPath)
+		if listErr != nil {
+			return emitError(listErr, *jsonOutput)
+		}
+		targets = []importTarget{{Name: strings.TrimSpace(*projectName), Path: resolvedProjectPath, Files: files}}
+		// Claude Code keeps each worktree's transcripts separately; import
+		// the other checkouts of the repository into the same project.
+		for _, checkout := range otherCheckouts(resolvedProjectPath) {
+			if wtFiles, wtErr := listTranscriptFiles(checkout); wtErr == nil && len(wtFiles) > 0 {
+				targets = append(targets, importTarget{Name: targets[0].Name, Path: checkout, Files: wtFiles})
+			}
+		}
+		totalFound := 0
+		for _, target := range targets {
+			totalFound += len(target.Files)
+		}
+		if totalFound == 0 {
+			return emitError(errors.New("no transcript files found"), *jsonOutput)
+		}
 	}
 
-	files, err := listTranscriptFiles(resolvedProjectPath)
-	if err != nil {
-		return emitError(err, *jsonOutput)
-	}
-	if len(files) == 0 {
-		return emitError(errors.New("no transcript files found"), *jsonOutput)
+	// Unchanged transcripts from an earlier run are skipped. Changed ones
+	// update their existing session, so only new ones count against quota.
+	newJobs := make([]importJob, 0)
+	changedJobs := make([]importJob, 0)
+	unchangedCount := 0
+	totalFiles := 0
+	unchangedByProject := map[string]int{}
+	for i := range targets {
+		// Name starts as the --project override (if any) and becomes the
+		// resolved project's name.
+		target := &targets[i]
+		project, ensureErr := ensureProject(client, target.Path, target.Name)
+		if ensureErr != nil {
+			if !*allProjects {
+				return emitError(ensureErr, *jsonOutput)
+			}
+			projectErrors = append(projectErrors, map[string]any{"projectPath": target.Path, "projectName": coalesce(target.Name, filepath.Base(target.Path)), "error": ensureErr.Error()})
+			continue
+		}
+		target.Name = project.GetName()
+		totalFiles += len(target.Files)
+		manifest := loadImportManifest(target.Path, target.Name)
+		for _, file := range target.Files {
+			info, statErr := os.Stat(file)
+			if statErr != nil {
+				continue
+			}
+			job := importJob{File: file, Size: info.Size(), ModTime: info.ModTime(), ProjectName: target.Name, ProjectPath: target.Path, Manifest: manifest, Transcript: transcriptOpts, Pricing: pricing}
+			entry, unchanged := manifest.lookup(file, job.Size, job.ModTime)
+			switch {
+			case unchanged && !*force:
+				unchangedCount++
+				unchangedByProject[target.Path]++
+			case entry.SessionID != "":
+				changedJobs = append(changedJobs, job)
+			default:
+				newJobs = append(newJobs, job)
+			}
+		}
 	}
 
-	_, err = ensureProject(client, resolvedProjectName, resolvedProjectPath, "")
-	if err != nil {
-		return emitError(err, *jsonOutput)
-	}
+	var filteredNew, filteredChanged int
+	newJobs, filteredNew = filterImportJobs(newJobs, filter, *newestFirst)
+	changedJobs, filteredChanged = filterImportJobs(changedJobs, filter, *newestFirst)
+	filteredCount := filteredNew + filteredChanged
 
-	sessionsToImport := len(files)
 	wasLimited := false
 	skippedCount := 0
-	if quota, quotaErr := client.GetSessionQuota(15 * time.Second); quotaErr == nil && quota.GetLimit() != -1 {
-		if quota.GetRemaining() <= 0 {
+	if quota, quotaErr := client.GetSessionQuota(15 * time.Second); quotaErr == nil && quota.GetLimit() != -1 && len(newJobs) > 0 {
+		if quota.GetRemaining() <= 0 && len(changedJobs) == 0 {
 			payload := map[string]any{
 				"success":      false,
 				"error":        "session_limit_exceeded",
@@ -420,74 +584,112 @@ func runImportAll(args []string) int {
 				"currentCount": quota.GetCurrentCount(),
 				"limit":        quota.GetLimit(),
 				"upgradeUrl":   "https://sessionhub.dev/pricing",
-				"totalFiles":   len(files),
+				"totalFiles":   totalFiles,
 			}
 			return emitJSONOrPretty(payload, true)
 		}
-		if int(quota.GetRemaining()) < len(files) {
-			sessionsToImport = int(quota.GetRemaining())
+		if remaining := max(int(quota.GetRemaining()), 0); remaining < len(newJobs) {
 			wasLimited = true
-			skippedCount = len(files) - sessionsToImport
+			skippedCount = len(newJobs) - remaining
+			newJobs = newJobs[:remaining]
 		}
 	}
 
-	targetFiles := files[:sessionsToImport]
-	results := make([]map[string]any, 0, len(targetFiles))
-	successCount := 0
-	errorCount := 0
+	jobs := append(changedJobs, newJobs...)
+	if *newestFirst {
+		sortImportJobsNewestFirst(jobs)
+	} else {
+		sort.Slice(jobs, func(i, j int) bool { return jobs[i].File < jobs[j].File })
+	}
 
-	for _, file := range targetFiles {
-		parsed, parseErr := parseTranscriptFile(file, 0)
-		if parseErr != nil {
-			errorCount++
-			results = append(results, map[string]any{"file": filepath.Base(file), "success": false, "error": parseErr.Error()})
-			continue
+	progressOut := json.NewEncoder(os.Stdout)
+	if *jsonOutput {
+		start := map[string]any{"event": "start", "totalFiles": totalFiles, "toImport": len(jobs), "unchangedCount": unchangedCount, "filteredCount": filteredCount}
+		if *allProjects {
+			start["projectCount"] = len(targets)
+		} else {
+			start["projectName"] = targets[0].Name
+		}
+		_ = progressOut.Encode(start)
+	}
+	var mapped *mappedProjects
+	if strings.TrimSpace(*projectName) == "" {
+		mapped = newMappedProjects(client, cfg.ProjectMappings)
+	}
+	outcomes := runImportJobs(client, jobs, *workers, typeOverride, mapped, func(done, total int, o importOutcome) {
+		if *jsonOutput {
+			event := o.payload()
+			event["event"] = "progress"
+			event["done"] = done
+			event["total"] = total
+			_ = progressOut.Encode(event)
+			return
+		}
+		status := "ok"
+		if o.Err != nil {
+			status = "error: " + o.Err.Error()
 		}
+		fmt.Fprintf(os.Stderr, "[%d/%d] %s %s\n", done, total, filepath.Base(o.Job.File), status)
+	})
 
-		req := &pb.CreateSessionRequest{
-			ProjectName:       resolvedProjectName,
-			ProjectPath:       stringPtr(resolvedProjectPath),
-			StartTime:         parsed.StartTime,
-			EndTime:           optionalString(parsed.EndTime),
-			Name:              stringPtr("Imported Session - " + time.Now().Format(time.RFC3339)),
-			ToolName:          coalesce(parsed.ToolName, "claude-code"),
-			GitBranch:         optionalString(parsed.GitBranch),
-			InputTokens:       parsed.TotalInputTokens,
-			OutputTokens:      parsed.TotalOutputTokens,
-			CacheCreateTokens: parsed.TotalCacheCreateTokens,
-			CacheReadTokens:   parsed.TotalCacheReadTokens,
-			Interactions:      parsed.Interactions,
-			PlanSlug:          optionalString(parsed.PlanSlug),
-			Metadata: map[string]string{
-				"import_source":       "cli_bulk",
-				"original_session_id": parsed.SessionID,
-			},
-		}
-
-		resp, upsertErr := client.UpsertSession(req, 60*time.Second)
-		if upsertErr != nil {
+	results := make([]map[string]any, 0, len(outcomes))
+	successCount := 0
+	errorCount := 0
+	byProject := map[string]map[string]any{}
+	for _, target := range targets {
+		byProject[target.Path] = map[string]any{
+			"projectName":    target.Name,
+			"projectPath":    target.Path,
+			"totalFiles":     len(target.Files),
+			"unchangedCount": unchangedByProject[target.Path],
+			"successCount":   0,
+			"errorCount":     0,
+		}
+	}
+	for _, o := range outcomes {
+		counts := byProject[o.Job.ProjectPath]
+		if o.Err != nil {
 			errorCount++
-			results = append(results, map[string]any{"file": filepath.Base(file), "success": false, "error": upsertErr.Error()})
-			continue
+			counts["errorCount"] = counts["errorCount"].(int) + 1
+		} else {
+			successCount++
+			counts["successCount"] = counts["successCount"].(int) + 1
 		}
-
-		successCount++
-		results = append(results, map[string]any{"file": filepath.Base(file), "success": true, "sessionId": resp.GetSessionId()})
+		result := o.payload()
+		if *allProjects {
+			result["projectName"] = o.Job.ProjectName
+		}
+		results = append(results, result)
 	}
 
 	payload := map[string]any{
-		"success":        errorCount == 0,
-		"projectName":    resolvedProjectName,
-		"totalFiles":     len(files),
-		"processedFiles": len(targetFiles),
+		"success":        errorCount == 0 && len(projectErrors) == 0,
+		"totalFiles":     totalFiles,
+		"processedFiles": len(jobs),
+		"unchangedCount": unchangedCount,
+		"filteredCount":  filteredCount,
 		"successCount":   successCount,
 		"errorCount":     errorCount,
 		"wasLimited":     wasLimited,
 		"results":        results,
 	}
+	if *allProjects {
+		projects := make([]map[string]any, 0, len(targets))
+		for _, target := range targets {
+			projects = append(projects, byProject[target.Path])
+		}
+		payload["projects"] = projects
+		payload["projectErrors"] = projectErrors
+		payload["unresolvedDirectories"] = unresolved
+	} else {
+		payload["projectName"] = targets[0].Name
+	}
 	if wasLimited {
 		payload["limitInfo"] = map[string]any{"skippedCount": skippedCount, "upgradeUrl": "https://sessionhub.dev/pricing"}
 	}
+	if *jsonOutput {
+		payload["event"] = "summary"
+	}
 	return emitJSONOrPretty(payload, *jsonOutput)
 }
 
@@ -514,11 +716,6 @@ func runObservations(args []string) int {
 			resolvedProjectName = last.ProjectName
 		}
 	}
-	if resolvedProjectName == "" {
-		if cwd, cwdErr := os.Getwd(); cwdErr == nil {
-			resolvedProjectName = filepath.Base(cwd)
-		}
-	}
 
 	projects, err := client.GetProjects(15 * time.Second)
 	if err != nil {
@@ -526,15 +723,16 @@ func runObservations(args []string) int {
 	}
 
 	var project *pb.Project
-	for _, p := range projects {
-		if p.GetName() == resolvedProjectName || p.GetDisplayName() == resolvedProjectName {
-			project = p
-			break
-		}
+	if resolvedProjectName != "" {
+		project = findProjectByName(projects, resolvedProjectName)
+	} else if cwd, cwdErr := os.Getwd(); cwdErr == nil {
+		resolvedProjectName = filepath.Base(cwd)
+		project = findProjectForPath(projects, cwd)
 	}
 	if project == nil {
 		return emitError(fmt.Errorf("project not found: %s", resolvedProjectName), *jsonOutput)
 	}
+	resolvedProjectName = project.GetName()
 
 	resp, err := client.GetProjectObservations(project.GetId(), int32(max(*limit, 1)), 20*time.Second)
 	if err != nil {
@@ -578,166 +776,62 @@ func runSyncSkills(args []string) int {
 	teamID := fsFlags.String("team", "", "Team ID")
 	projectID := fsFlags.String("project", "", "Project ID filter")
 	scope := fsFlags.String("scope", "", "Scope filter: team or project")
+	onConflict := fsFlags.String("on-conflict", "skip", "Action for locally modified skills: skip, backup, or remote")
+	force := fsFlags.Bool("force", false, "Overwrite or remove locally modified skills")
+	dryRun := fsFlags.Bool("dry-run", false, "Show what would change without writing anything")
+	watch := fsFlags.Bool("watch", false, "Keep running and sync whenever the interval has elapsed")
+	interval := fsFlags.Duration("interval", 5*time.Minute, "Minimum time between syncs in --watch mode")
 	apiKeyOverride := fsFlags.String("api-key", "", "API key override")
 	jsonOutput := fsFlags.Bool("json", false, "Emit JSON output")
 	if err := fsFlags.Parse(args); err != nil {
 		return 2
 	}
 
-	_, client, _, err := initializeAuthenticatedClient(*apiKeyOverride, 20*time.Second)
-	if err != nil {
-		return emitError(err, *jsonOutput)
+	conflictAction := strings.ToLower(strings.TrimSpace(*onConflict))
+	if conflictAction != "skip" && conflictAction != "backup" && conflictAction != "remote" {
+		return emitError(fmt.Errorf("invalid --on-conflict %q: use skip, backup, or remote", *onConflict), *jsonOutput)
 	}
-	defer client.Close()
-
-	resolvedTeamID := strings.TrimSpace(*teamID)
-	teamSlug := ""
-	if resolvedTeamID == "" {
-		teams, teamErr := client.ListUserTeams(20 * time.Second)
-		if teamErr != nil {
-			return emitError(teamErr, *jsonOutput)
-		}
-		if len(teams) == 0 {
-			return emitError(errors.New("no teams found. Join or create a team first"), *jsonOutput)
-		}
-		resolvedTeamID = teams[0].GetId()
-		teamSlug = teams[0].GetSlug()
+	if *watch && *dryRun {
+		return emitError(errors.New("use either --watch or --dry-run, not both"), *jsonOutput)
 	}
-
-	skills, err := client.GetTeamSkills(
-		resolvedTeamID,
-		optionalString(strings.TrimSpace(*projectID)),
-		optionalString(strings.TrimSpace(*scope)),
-		20*time.Second,
-	)
-	if err != nil {
-		return emitError(err, *jsonOutput)
+	if *watch && *interval < time.Minute {
+		return emitError(errors.New("--interval must be at least 1m"), *jsonOutput)
 	}
 
-	home, _ := os.UserHomeDir()
-	skillsDir := filepath.Join(home, ".claude", "skills")
-	if err := os.MkdirAll(skillsDir, 0o755); err != nil {
+	_, client, _, err := initializeAuthenticatedClient(*apiKeyOverride, 20*time.Second)
+	if err != nil {
 		return emitError(err, *jsonOutput)
 	}
-	resolvedSkillsDir, _ := filepath.Abs(skillsDir)
+	defer client.Close()
 
-	cachePath := filepath.Join(filepath.Dir(configPath()), "skills-cache.json")
-	cache := map[string]map[string]any{}
-	if data, readErr := os.ReadFile(cachePath); readErr == nil {
-		_ = json.Unmarshal(data, &cache)
+	opts := skillSyncOptions{
+		TeamID:         *teamID,
+		ProjectID:      *projectID,
+		Scope:          *scope,
+		ConflictAction: conflictAction,
+		Force:          *force,
 	}
-
-	teamPrefix := teamSlug
-	if teamPrefix == "" {
-		if len(resolvedTeamID) > 8 {
-			teamPrefix = resolvedTeamID[:8]
-		} else {
-			teamPrefix = resolvedTeamID
-		}
+	if *watch {
+		return watchSkillSync(client, opts, *interval, *jsonOutput)
 	}
 
-	currentSlugs := map[string]bool{}
-	newCount := 0
-	updatedCount := 0
-	unchangedCount := 0
-
-	for _, skill := range skills {
-		effectiveSlug := fmt.Sprintf("%s-%s", teamPrefix, skill.GetSlug())
-		skillDir := filepath.Join(skillsDir, effectiveSlug)
-		resolvedDir, _ := filepath.Abs(skillDir)
-		if !strings.HasPrefix(resolvedDir, resolvedSkillsDir+string(os.PathSeparator)) {
-			continue
-		}
-		currentSlugs[effectiveSlug] = true
-
-		if cached, ok := cache[effectiveSlug]; ok {
-			if toInt64(cached["version"]) == int64(skill.GetVersion()) {
-				unchangedCount++
-				continue
-			}
-		}
-
-		if err := os.MkdirAll(skillDir, 0o755); err != nil {
-			continue
-		}
-
-		desc := strings.ReplaceAll(skill.GetSummary(), "\n", " ")
-		if strings.TrimSpace(desc) == "" {
-			desc = strings.ReplaceAll(skill.GetTitle(), "\n", " ")
-		}
-		desc = strings.ReplaceAll(desc, "\"", "\\\"")
-		frontmatter := fmt.Sprintf("---\nname: %s\ndescription: \"%s\"\n---\n\n", effectiveSlug, desc)
-
-		files := skill.GetFiles()
-		if len(files) > 1 {
-			for relPath, content := range files {
-				if strings.Contains(relPath, "..") || strings.HasPrefix(relPath, "/") {
-					continue
-				}
-				fullPath := filepath.Join(skillDir, relPath)
-				absPath, _ := filepath.Abs(fullPath)
-				if !strings.HasPrefix(absPath, resolvedDir+string(os.PathSeparator)) {
-					continue
-				}
-				if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
-					continue
-				}
-				isEntry := relPath == "SKILL.md" || relPath == "index.md" || relPath == "README.md"
-				out := content
-				if isEntry {
-					out = frontmatter + out
-				}
-				_ = os.WriteFile(fullPath, []byte(out), 0o644)
-			}
-		} else {
-			out := frontmatter + skill.GetContent()
-			_ = os.WriteFile(filepath.Join(skillDir, "SKILL.md"), []byte(out), 0o644)
-		}
-
-		if _, ok := cache[effectiveSlug]; ok {
-			updatedCount++
-		} else {
-			newCount++
-		}
-		cache[effectiveSlug] = map[string]any{"version": skill.GetVersion(), "slug": skill.GetSlug()}
+	run, err := prepareSkillSync(client, opts)
+	if err != nil {
+		return emitError(err, *jsonOutput)
 	}
-
-	removedCount := 0
-	for slug := range cache {
-		if currentSlugs[slug] {
-			continue
-		}
-		skillDir := filepath.Join(skillsDir, slug)
-		resolvedDir, _ := filepath.Abs(skillDir)
-		if strings.HasPrefix(resolvedDir, resolvedSkillsDir+string(os.PathSeparator)) {
-			_ = os.RemoveAll(skillDir)
-		}
-		delete(cache, slug)
-		removedCount++
+	if *dryRun {
+		return emitSkillSyncPlan(run.Plan, run.TeamID, run.SkillsDir, conflictAction, *jsonOutput)
 	}
 
-	if err := os.MkdirAll(filepath.Dir(cachePath), 0o700); err == nil {
-		if payload, marshalErr := json.MarshalIndent(cache, "", "  "); marshalErr == nil {
-			_ = os.WriteFile(cachePath, payload, 0o600)
-		}
+	result, err := run.apply(conflictAction)
+	if err != nil {
+		return emitError(err, *jsonOutput)
 	}
-
-	payload := map[string]any{
-		"success":      true,
-		"teamId":       resolvedTeamID,
-		"skillsSynced": len(skills),
-		"new":          newCount,
-		"updated":      updatedCount,
-		"unchanged":    unchangedCount,
-		"removed":      removedCount,
-		"skillsDir":    skillsDir,
-	}
-	if len(skills) == 0 {
-		payload["message"] = fmt.Sprintf("No approved team skills found; removed %d previously synced skills", removedCount)
-	} else {
-		payload["message"] = fmt.Sprintf("Synced %d skills (%d new, %d updated, %d removed)", len(skills), newCount, updatedCount, removedCount)
+	emitJSONOrPretty(result.payload(), *jsonOutput)
+	if len(result.Failed) > 0 {
+		return 1
 	}
-	return emitJSONOrPretty(payload, *jsonOutput)
+	return 0
 }
 
 func runPushSkill(args []string) int {
@@ -749,6 +843,9 @@ func runPushSkill(args []string) int {
 	category := fsFlags.String("category", "", "Skill category")
 	tagsCSV := fsFlags.String("tags", "", "Comma-separated tags")
 	summary := fsFlags.String("summary", "", "Short summary")
+	slug := fsFlags.String("slug", "", "Slug of an existing team skill to update")
+	createNew := fsFlags.Bool("new", false, "Always create a new draft instead of updating an existing skill")
+	skipLint := fsFlags.Bool("skip-lint", false, "Push even if skill lint reports errors")
 	apiKeyOverride := fsFlags.String("api-key", "", "API key override")
 	jsonOutput := fsFlags.Bool("json", false, "Emit JSON output")
 	if err := fsFlags.Parse(args); err != nil {
@@ -761,61 +858,68 @@ func runPushSkill(args []string) int {
 	if strings.TrimSpace(*filePath) != "" && strings.TrimSpace(*dirPath) != "" {
 		return emitError(errors.New("use either --file or --dir, not both"), *jsonOutput)
 	}
+	if *createNew && strings.TrimSpace(*slug) != "" {
+		return emitError(errors.New("use either --slug or --new, not both"), *jsonOutput)
+	}
+
+	var bundle *skillBundle
+	var err error
+	if strings.TrimSpace(*dirPath) != "" {
+		bundle, err = loadSkillDir(strings.TrimSpace(*dirPath))
+	} else {
+		bundle, err = loadSkillFile(strings.TrimSpace(*filePath))
+	}
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+
+	lintIssues := []skillLintIssue{}
+	if !*skipLint {
+		lintIssues = lintSkillBundle(bundle)
+		if errCount, _ := countLintIssues(lintIssues); errCount > 0 {
+			emitJSONOrPretty(map[string]any{
+				"success": false,
+				"error":   fmt.Sprintf("skill lint failed with %d error%s; fix them or re-run with --skip-lint", errCount, plural(errCount)),
+				"issues":  lintIssues,
+			}, *jsonOutput)
+			return 1
+		}
+	}
 
+	// Lint runs before connecting, so a local problem is reported without
+	// network access or credentials.
 	_, client, _, err := initializeAuthenticatedClient(*apiKeyOverride, 20*time.Second)
 	if err != nil {
 		return emitError(err, *jsonOutput)
 	}
 	defer client.Close()
 
+	// Text files also go in the legacy string map so older backends keep
+	// working; bundle_files carries every file byte-for-byte with its mode.
 	filesMap := map[string]string{}
+	bundleFiles := map[string]*pb.SkillFile{}
+	for rel, data := range bundle.Files {
+		if isTextSkillData(data) {
+			filesMap[rel] = string(data)
+		}
+		bundleFiles[rel] = &pb.SkillFile{
+			Content:     data,
+			ContentType: skillFileContentType(rel, data),
+			Executable:  bundle.Executable[rel],
+		}
+	}
 	resolvedTitle := strings.TrimSpace(*title)
 	resolvedSummary := strings.TrimSpace(*summary)
 	skillContent := ""
+	slugCandidates := []string{}
 
 	if strings.TrimSpace(*dirPath) != "" {
 		base := strings.TrimSpace(*dirPath)
-		info, statErr := os.Stat(base)
-		if statErr != nil || !info.IsDir() {
-			return emitError(fmt.Errorf("directory not found: %s", base), *jsonOutput)
-		}
-
-		_ = filepath.WalkDir(base, func(path string, d fs.DirEntry, walkErr error) error {
-			if walkErr != nil || d.IsDir() {
-				return nil
-			}
-			rel, relErr := filepath.Rel(base, path)
-			if relErr != nil || strings.Contains(rel, "..") {
-				return nil
-			}
-			b, readErr := os.ReadFile(path)
-			if readErr == nil {
-				filesMap[filepath.ToSlash(rel)] = string(b)
-			}
-			return nil
-		})
-		if len(filesMap) == 0 {
-			return emitError(fmt.Errorf("no files found in %s", base), *jsonOutput)
-		}
-
-		entry := ""
-		for _, candidate := range []string{"SKILL.md", "index.md", "README.md"} {
-			if v, ok := filesMap[candidate]; ok {
-				entry = v
-				break
-			}
-		}
-		if entry == "" {
-			for k, v := range filesMap {
-				if strings.HasSuffix(strings.ToLower(k), ".md") {
-					entry = v
-					break
-				}
-			}
-		}
-		if entry != "" {
-			content, fmTitle, fmSummary := parseFrontmatter(entry)
+		slugCandidates = append(slugCandidates, filepath.Base(filepath.Clean(base)))
+		if bundle.Entry != "" {
+			content, fmTitle, fmSummary := parseFrontmatter(string(bundle.Files[bundle.Entry]))
 			skillContent = content
+			slugCandidates = append(slugCandidates, fmTitle)
 			if resolvedTitle == "" && fmTitle != "" {
 				resolvedTitle = fmTitle
 			}
@@ -828,13 +932,14 @@ func runPushSkill(args []string) int {
 		}
 	} else {
 		path := strings.TrimSpace(*filePath)
-		b, readErr := os.ReadFile(path)
-		if readErr != nil {
-			return emitError(fmt.Errorf("could not read file %s: %w", path, readErr), *jsonOutput)
-		}
-		content := string(b)
 		var fmTitle, fmSummary string
-		skillContent, fmTitle, fmSummary = parseFrontmatter(content)
+		skillContent, fmTitle, fmSummary = parseFrontmatter(string(bundle.Files["SKILL.md"]))
+		if strings.EqualFold(filepath.Base(path), "SKILL.md") {
+			slugCandidates = append(slugCandidates, filepath.Base(filepath.Dir(path)))
+		} else {
+			slugCandidates = append(slugCandidates, strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+		}
+		slugCandidates = append(slugCandidates, fmTitle)
 		if resolvedTitle == "" && fmTitle != "" {
 			resolvedTitle = fmTitle
 		}
@@ -844,7 +949,6 @@ func runPushSkill(args []string) int {
 		if resolvedTitle == "" {
 			resolvedTitle = titleCase(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
 		}
-		filesMap["SKILL.md"] = content
 	}
 
 	resolvedTeamID := strings.TrimSpace(*teamID)
@@ -867,14 +971,66 @@ func runPushSkill(args []string) int {
 		}
 	}
 
+	fileCount := len(bundleFiles)
+
+	if !*createNew {
+		existing, baseVersion, findErr := findExistingTeamSkill(client, resolvedTeamID, strings.TrimSpace(*slug), append(slugCandidates, slugify(resolvedTitle)))
+		if findErr != nil {
+			return emitError(findErr, *jsonOutput)
+		}
+		if existing != nil {
+			// Frontmatter names are identifiers, so an update keeps the
+			// published title and metadata unless overridden by flags.
+			updateTitle := coalesce(strings.TrimSpace(*title), existing.GetTitle())
+			updateCategory := coalesce(strings.TrimSpace(*category), existing.GetCategory())
+			if len(tags) == 0 {
+				tags = existing.GetTags()
+			}
+			resp, updateErr := client.UpdateTeamSkill(&pb.UpdateTeamSkillRequest{
+				TeamId:      resolvedTeamID,
+				SkillId:     existing.GetId(),
+				Title:       updateTitle,
+				Content:     skillContent,
+				Summary:     optionalString(coalesce(resolvedSummary, existing.GetSummary())),
+				Category:    optionalString(updateCategory),
+				Tags:        tags,
+				Files:       filesMap,
+				BundleFiles: bundleFiles,
+				BaseVersion: &baseVersion,
+			}, 20*time.Second)
+			if updateErr != nil {
+				return emitError(updateErr, *jsonOutput)
+			}
+			payload := map[string]any{
+				"success":         true,
+				"updated":         true,
+				"skillId":         resp.GetSkillId(),
+				"slug":            resp.GetSlug(),
+				"title":           updateTitle,
+				"teamId":          resolvedTeamID,
+				"fileCount":       fileCount,
+				"previousVersion": resp.GetPreviousVersion(),
+				"newVersion":      resp.GetNewVersion(),
+				"lintIssues":      lintIssues,
+				"message": fmt.Sprintf("Submitted update to \"%s\" as draft v%d (published: v%d, %d file%s) — submit for review in the web UI",
+					resp.GetSlug(), resp.GetNewVersion(), resp.GetPreviousVersion(), fileCount, plural(fileCount)),
+			}
+			if baseVersion < resp.GetPreviousVersion() {
+				payload["warning"] = fmt.Sprintf("local copy was based on v%d but v%d is published; review the draft for lost changes", baseVersion, resp.GetPreviousVersion())
+			}
+			return emitJSONOrPretty(payload, *jsonOutput)
+		}
+	}
+
 	req := &pb.CreateTeamSkillRequest{
-		TeamId:   resolvedTeamID,
-		Title:    resolvedTitle,
-		Content:  skillContent,
-		Summary:  optionalString(resolvedSummary),
-		Category: optionalString(strings.TrimSpace(*category)),
-		Tags:     tags,
-		Files:    filesMap,
+		TeamId:      resolvedTeamID,
+		Title:       resolvedTitle,
+		Content:     skillContent,
+		Summary:     optionalString(resolvedSummary),
+		Category:    optionalString(strings.TrimSpace(*category)),
+		Tags:        tags,
+		Files:       filesMap,
+		BundleFiles: bundleFiles,
 	}
 
 	resp, err := client.CreateTeamSkill(req, 20*time.Second)
@@ -882,19 +1038,104 @@ func runPushSkill(args []string) int {
 		return emitError(err, *jsonOutput)
 	}
 
-	fileCount := len(filesMap)
 	payload := map[string]any{
-		"success":   true,
-		"skillId":   resp.GetSkillId(),
-		"slug":      resp.GetSlug(),
-		"title":     resolvedTitle,
-		"teamId":    resolvedTeamID,
-		"fileCount": fileCount,
-		"message":   fmt.Sprintf("Created draft skill \"%s\" (%d file%s) — submit for review in the web UI", resp.GetSlug(), fileCount, plural(fileCount)),
+		"success":    true,
+		"updated":    false,
+		"skillId":    resp.GetSkillId(),
+		"slug":       resp.GetSlug(),
+		"title":      resolvedTitle,
+		"teamId":     resolvedTeamID,
+		"fileCount":  fileCount,
+		"lintIssues": lintIssues,
+		"message":    fmt.Sprintf("Created draft skill \"%s\" (%d file%s) — submit for review in the web UI", resp.GetSlug(), fileCount, plural(fileCount)),
 	}
 	return emitJSONOrPretty(payload, *jsonOutput)
 }
 
+func runSkill(args []string) int {
+	if len(args) < 1 {
+		fmt.Fprintln(os.Stderr, "usage: sessionhub skill lint --file <path> | --dir <path>")
+		return 2
+	}
+
+	switch args[0] {
+	case "lint":
+		return runSkillLint(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "unknown skill subcommand: %s\n", args[0])
+		return 2
+	}
+}
+
+func runSkillLint(args []string) int {
+	fsFlags := flag.NewFlagSet("skill lint", flag.ContinueOnError)
+	filePath := fsFlags.String("file", "", "Path to skill .md file")
+	dirPath := fsFlags.String("dir", "", "Path to skill directory")
+	jsonOutput := fsFlags.Bool("json", false, "Emit JSON output")
+	if err := fsFlags.Parse(args); err != nil {
+		return 2
+	}
+	if strings.TrimSpace(*dirPath) == "" && strings.TrimSpace(*filePath) == "" && fsFlags.NArg() > 0 {
+		*dirPath = fsFlags.Arg(0)
+		if info, statErr := os.Stat(*dirPath); statErr == nil && !info.IsDir() {
+			*filePath, *dirPath = *dirPath, ""
+		}
+	}
+	if strings.TrimSpace(*filePath) == "" && strings.TrimSpace(*dirPath) == "" {
+		return emitError(errors.New("--file or --dir is required"), *jsonOutput)
+	}
+	if strings.TrimSpace(*filePath) != "" && strings.TrimSpace(*dirPath) != "" {
+		return emitError(errors.New("use either --file or --dir, not both"), *jsonOutput)
+	}
+
+	var bundle *skillBundle
+	var err error
+	if strings.TrimSpace(*dirPath) != "" {
+		bundle, err = loadSkillDir(strings.TrimSpace(*dirPath))
+	} else {
+		bundle, err = loadSkillFile(strings.TrimSpace(*filePath))
+	}
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+
+	issues := lintSkillBundle(bundle)
+	errCount, warnCount := countLintIssues(issues)
+	totalBytes := 0
+	for _, data := range bundle.Files {
+		totalBytes += len(data)
+	}
+
+	if !*jsonOutput {
+		for _, issue := range issues {
+			location := bundle.Root
+			if issue.File != "" {
+				location = issue.File
+			}
+			fmt.Printf("%s: %s: %s\n", issue.Severity, location, issue.Message)
+		}
+		fmt.Printf("%d file%s, %s, %d ignored: %d error%s, %d warning%s\n",
+			len(bundle.Files), plural(len(bundle.Files)), formatBytes(totalBytes), len(bundle.Ignored),
+			errCount, plural(errCount), warnCount, plural(warnCount))
+	} else {
+		emitJSONOrPretty(map[string]any{
+			"success":    errCount == 0,
+			"path":       bundle.Root,
+			"entry":      bundle.Entry,
+			"fileCount":  len(bundle.Files),
+			"totalBytes": totalBytes,
+			"ignored":    bundle.Ignored,
+			"errors":     errCount,
+			"warnings":   warnCount,
+			"issues":     issues,
+		}, true)
+	}
+	if errCount > 0 {
+		return 1
+	}
+	return 0
+}
+
 func runHook(args []string) int {
 	if len(args) < 1 {
 		fmt.Fprintln(os.Stderr, "usage: sessionhub hook session-start")
@@ -905,9 +1146,11 @@ func runHook(args []string) int {
 	case "session-start":
 		return runHookSessionStart()
 	case "session-start-context":
-		return emitEmptySessionStartContext()
+		return runHookSessionStartContext()
 	case "session-start-clear-capture":
 		return emitEmptySessionStartContext()
+	case "session-start-sync-skills":
+		return runHookSessionStartSyncSkills()
 	case "session-end":
 		return 0
 	default:
@@ -920,18 +1163,9 @@ func runHookSessionStart() int {
 	input := readHookInput()
 	cfg, _ := loadConfig()
 	configured := strings.TrimSpace(cfg.User.APIKey) != ""
+	projectDir := hookProjectDir(input)
 
-	projectDir := strings.TrimSpace(os.Getenv("CLAUDE_PROJECT_DIR"))
-	if projectDir == "" {
-		projectDir = strings.TrimSpace(input.Cwd)
-	}
-	if projectDir == "" {
-		if cwd, err := os.Getwd(); err == nil {
-			projectDir = cwd
-		}
-	}
-
-	appendProjectDirToEnv(projectDir)
+	appendSessionEnv(projectDir, strings.TrimSpace(input.SessionID))
 
 	contextParts := make([]string, 0, 2)
 	if !configured {
@@ -940,6 +1174,8 @@ func runHookSessionStart() int {
 		)
 	}
 
+	// Also runs for the compact source: the markers do not survive in the
+	// compaction summary, so they are injected again.
 	if uuidPattern.MatchString(strings.TrimSpace(input.SessionID)) {
 		contextParts = append(contextParts,
 			fmt.Sprintf("[SESSIONHUB_SESSION_ID:%s] [SESSIONHUB_PROJECT_DIR:%s]", input.SessionID, projectDir),
@@ -957,6 +1193,56 @@ func runHookSessionStart() int {
 	return 0
 }
 
+const skillSyncHookBudget = 20 * time.Second
+
+// runHookSessionStartSyncSkills syncs team skills at session start when
+// skills.autoSync is enabled in config. It is rate-limited by the skills
+// cache and never fails the hook.
+func runHookSessionStartSyncSkills() int {
+	cfg, _ := loadConfig()
+	if !cfg.Skills.AutoSync || strings.TrimSpace(cfg.User.APIKey) == "" {
+		return 0
+	}
+	interval := 15 * time.Minute
+	if cfg.Skills.SyncIntervalMinutes > 0 {
+		interval = time.Duration(cfg.Skills.SyncIntervalMinutes) * time.Minute
+	}
+	if time.Since(loadSkillsCache().lastSynced()) < interval {
+		return 0
+	}
+
+	// One deadline covers connecting and fetching, leaving time to write the
+	// skills before hooks.json's 30s timeout; the key is not validated
+	// separately.
+	deadline := time.Now().Add(skillSyncHookBudget)
+	client, err := newAPIClient(cfg, strings.TrimSpace(cfg.User.APIKey), time.Until(deadline))
+	if err != nil {
+		return 0
+	}
+	defer client.Close()
+
+	run, err := prepareSkillSync(client, skillSyncOptions{ConflictAction: "skip", Deadline: deadline})
+	if err != nil {
+		return 0
+	}
+	_, _ = run.apply("skip")
+	return 0
+}
+
+// hookProjectDir is the directory Claude Code was started in.
+func hookProjectDir(input hookInput) string {
+	projectDir := strings.TrimSpace(os.Getenv("CLAUDE_PROJECT_DIR"))
+	if projectDir == "" {
+		projectDir = strings.TrimSpace(input.Cwd)
+	}
+	if projectDir == "" {
+		if cwd, err := os.Getwd(); err == nil {
+			projectDir = cwd
+		}
+	}
+	return projectDir
+}
+
 func emitEmptySessionStartContext() int {
 	output := hookOutput{}
 	output.HookSpecificOutput.HookEventName = "SessionStart"
@@ -965,7 +1251,7 @@ func emitEmptySessionStartContext() int {
 	return 0
 }
 
-func parseTranscriptFile(filePath string, lastExchanges int) (*parsedSession, error) {
+func parseTranscriptFile(filePath string, lastExchanges int, opts transcriptOptions) (*parsedSession, error) {
 	data, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("read transcript: %w", err)
@@ -974,6 +1260,15 @@ func parseTranscriptFile(filePath string, lastExchanges int) (*parsedSession, er
 
 	parsed := &parsedSession{ToolName: "claude-code"}
 	interactions := make([]*pb.InteractionData, 0, 512)
+	// Summary entries point at their conversation's last message by UUID; a
+	// resumed transcript can carry summaries of other sessions too.
+	uuids := map[string]bool{}
+	summaries := make(map[string]string)
+	summaryOrder := make([]string, 0)
+	toolResults := make(map[string]transcriptToolUse)
+	var ledger usageLedger
+	// compaction is the last compaction still waiting for its summary.
+	var compaction *pb.InteractionData
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
 		if line == "" {
@@ -1006,12 +1301,41 @@ func parseTranscriptFile(filePath string, lastExchanges int) (*parsedSession, er
 		}
 
 		typeName := strings.ToLower(asString(entry["type"]))
+		if uuid := asString(entry["uuid"]); uuid != "" {
+			uuids[uuid] = true
+		}
+		if isCompactBoundary(entry, typeName) {
+			compaction = compactionInteraction(entry, ts)
+			interactions = append(interactions, compaction)
+			parsed.Compactions++
+			continue
+		}
+		if typeName == "summary" {
+			if summary, leaf := asString(entry["summary"]), asString(entry["leafUuid"]); summary != "" && leaf != "" {
+				summaries[leaf] = summary
+				summaryOrder = append(summaryOrder, leaf)
+			}
+			continue
+		}
 		msg := asMap(entry["message"])
 		role := strings.ToLower(asString(msg["role"]))
 		content := msg["content"]
 
 		if (typeName == "user" || typeName == "human") && role == "user" {
+			collectToolResults(content, asMap(entry["toolUseResult"]), toolResults)
 			prompt := extractUserText(content)
+			// The summary a compaction continues from is not something the
+			// user typed; it becomes the compaction's content.
+			if entry["isCompactSummary"] == true {
+				if compaction == nil {
+					compaction = &pb.InteractionData{Timestamp: ts, InteractionType: "compaction", Metadata: map[string]string{}}
+					interactions = append(interactions, compaction)
+					parsed.Compactions++
+				}
+				compaction.Content = coalesce(prompt, compaction.GetContent())
+				compaction = nil
+				continue
+			}
 			if prompt != "" && !isSystemMessage(prompt) {
 				interactions = append(interactions, &pb.InteractionData{
 					Timestamp:       ts,
@@ -1023,28 +1347,38 @@ func parseTranscriptFile(filePath string, lastExchanges int) (*parsedSession, er
 		}
 
 		if typeName == "assistant" && role == "assistant" {
-			response := extractAssistantText(content)
-			usage := asMap(msg["usage"])
-			inTok := toInt64(usage["input_tokens"])
-			outTok := toInt64(usage["output_tokens"])
-			cacheCreate := toInt64(usage["cache_creation_input_tokens"])
-			cacheRead := toInt64(usage["cache_read_input_tokens"])
-			parsed.TotalInputTokens += inTok
-			parsed.TotalOutputTokens += outTok
-			parsed.TotalCacheCreateTokens += cacheCreate
-			parsed.TotalCacheReadTokens += cacheRead
-
-			if response != "" {
-				interactions = append(interactions, &pb.InteractionData{
+			usageIndex := ledger.record(asString(msg["id"]), asString(msg["model"]), len(interactions), usageFromMessage(asMap(msg["usage"])))
+			if opts.IncludeThinking {
+				if thinking := extractThinkingText(content); thinking != "" {
+					text, truncated := limitThinking(thinking, opts.MaxThinkingChars)
+					md := map[string]string{}
+					if truncated {
+						md["truncated"] = "true"
+					}
+					interactions = append(interactions, &pb.InteractionData{
+						Timestamp:       ts,
+						InteractionType: "thinking",
+						Content:         text,
+						Metadata:        md,
+					})
+				}
+			}
+			if response := extractAssistantText(content); response != "" {
+				it := &pb.InteractionData{
 					Timestamp:       ts,
 					InteractionType: "response",
 					Content:         response,
 					Metadata:        map[string]string{},
-					InputTokens:     int64Ptr(inTok),
-					OutputTokens:    int64Ptr(outTok),
-				})
+				}
+				// A response split over several lines reports its usage on
+				// the first text only; the counts are filled in after parsing.
+				ledger.attach(usageIndex, it)
+				interactions = append(interactions, it)
 			}
 
+			for _, block := range extractToolUseBlocks(content) {
+				parsed.ToolUses = append(parsed.ToolUses, transcriptToolUse{ID: asString(block["id"]), Name: asString(block["name"]), Input: asMap(block["input"]), Timestamp: ts})
+			}
 			for _, tool := range extractToolUses(content) {
 				toolCopy := tool
 				interactions = append(interactions, &pb.InteractionData{
@@ -1065,11 +1399,52 @@ func parseTranscriptFile(filePath string, lastExchanges int) (*parsedSession, er
 		parsed.SessionID = strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
 	}
 
+	for i := range parsed.ToolUses {
+		if result, ok := toolResults[parsed.ToolUses[i].ID]; ok {
+			parsed.ToolUses[i].Failed = result.Failed
+			parsed.ToolUses[i].Created = result.Created
+			parsed.ToolUses[i].OriginalFile = result.OriginalFile
+		}
+	}
+	for i := len(summaryOrder) - 1; i >= 0; i-- {
+		if uuids[summaryOrder[i]] {
+			parsed.Summary = summaries[summaryOrder[i]]
+			break
+		}
+	}
+	for _, it := range interactions {
+		if it.GetInteractionType() == "prompt" && isSubstantivePrompt(it.GetContent()) {
+			parsed.FirstPrompt = it.GetContent()
+			break
+		}
+	}
+
+	ledger.applyToInteractions()
 	parsed.Interactions = applyLastExchangeFilter(interactions, lastExchanges)
-	parsed.TotalInputTokens, parsed.TotalOutputTokens = recomputeTokens(parsed.Interactions, parsed.TotalInputTokens, parsed.TotalOutputTokens)
+	total, byModel := ledger.totals(len(interactions) - len(parsed.Interactions))
+	parsed.TotalInputTokens = total.InputTokens
+	parsed.TotalOutputTokens = total.OutputTokens
+	parsed.TotalCacheCreateTokens = total.CacheCreateTokens
+	parsed.TotalCacheReadTokens = total.CacheReadTokens
+	parsed.TokensByModel = byModel
 	return parsed, nil
 }
 
+// transcriptMetadata builds the session metadata sent with a parsed
+// transcript.
+func transcriptMetadata(parsed *parsedSession, importSource string) map[string]string {
+	md := map[string]string{
+		"import_source":       importSource,
+		"original_session_id": parsed.SessionID,
+	}
+	addLanguageMetadata(md, detectLanguages(parsed))
+	addTokenMetadata(md, parsed.TokensByModel)
+	if parsed.Compactions > 0 {
+		md["compaction_count"] = strconv.Itoa(parsed.Compactions)
+	}
+	return md
+}
+
 func applyLastExchangeFilter(interactions []*pb.InteractionData, lastExchanges int) []*pb.InteractionData {
 	if lastExchanges <= 0 {
 		return interactions
@@ -1087,18 +1462,6 @@ func applyLastExchangeFilter(interactions []*pb.InteractionData, lastExchanges i
 	return interactions[start:]
 }
 
-func recomputeTokens(interactions []*pb.InteractionData, fallbackIn, fallbackOut int64) (int64, int64) {
-	var inTok, outTok int64
-	for _, it := range interactions {
-		inTok += it.GetInputTokens()
-		outTok += it.GetOutputTokens()
-	}
-	if inTok == 0 && outTok == 0 {
-		return fallbackIn, fallbackOut
-	}
-	return inTok, outTok
-}
-
 func extractUserText(content any) string {
 	switch v := content.(type) {
 	case string:
@@ -1141,6 +1504,42 @@ func extractAssistantText(content any) string {
 	}
 }
 
+func extractToolUseBlocks(content any) []map[string]any {
+	arr, ok := content.([]any)
+	if !ok {
+		return nil
+	}
+	out := make([]map[string]any, 0)
+	for _, item := range arr {
+		m := asMap(item)
+		if strings.ToLower(asString(m["type"])) == "tool_use" && asString(m["name"]) != "" {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// collectToolResults records, by tool_use_id, whether each tool_result block
+// in a user message is an error, plus what the entry's toolUseResult says
+// about the file it changed.
+func collectToolResults(content any, toolUseResult map[string]any, results map[string]transcriptToolUse) {
+	arr, ok := content.([]any)
+	if !ok {
+		return
+	}
+	for _, item := range arr {
+		m := asMap(item)
+		if strings.ToLower(asString(m["type"])) != "tool_result" || asString(m["tool_use_id"]) == "" {
+			continue
+		}
+		result := transcriptToolUse{Failed: m["is_error"] == true, Created: asString(toolUseResult["type"]) == "create"}
+		if original, isString := toolUseResult["originalFile"].(string); isString {
+			result.OriginalFile = &original
+		}
+		results[asString(m["tool_use_id"])] = result
+	}
+}
+
 func extractToolUses(content any) []string {
 	arr, ok := content.([]any)
 	if !ok {
@@ -1214,7 +1613,10 @@ func findLatestTranscriptFile(projectPath, sessionID string) (string, error) {
 }
 
 func listTranscriptFiles(projectPath string) ([]string, error) {
-	dir := claudeProjectDir(projectPath)
+	return listTranscriptFilesInDir(claudeProjectDir(projectPath))
+}
+
+func listTranscriptFilesInDir(dir string) ([]string, error) {
 	entries, err := os.ReadDir(dir)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
@@ -1235,10 +1637,14 @@ func listTranscriptFiles(projectPath string) ([]string, error) {
 }
 
 func claudeProjectDir(projectPath string) string {
-	home, _ := os.UserHomeDir()
 	replacer := strings.NewReplacer("/", "-", "\\", "-", "_", "-")
 	dirName := replacer.Replace(projectPath)
-	return filepath.Join(home, ".claude", "projects", dirName)
+	return filepath.Join(claudeProjectsRoot(), dirName)
+}
+
+func claudeProjectsRoot() string {
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".claude", "projects")
 }
 
 func quickExtractSessionID(filePath string) (string, error) {
@@ -1259,34 +1665,17 @@ func quickExtractSessionID(filePath string) (string, error) {
 	return "", nil
 }
 
-func ensureProject(client *apiClient, projectName, projectPath, gitBranch string) (*pb.Project, error) {
-	projects, err := client.GetProjects(20 * time.Second)
-	if err != nil {
-		return nil, err
-	}
-	for _, p := range projects {
-		if p.GetName() == projectName || p.GetDisplayName() == projectName {
-			return p, nil
-		}
-	}
-
-	desc := fmt.Sprintf("Auto-created project from CLI for %s", projectName)
-	gitRemote := detectGitRemote(projectPath)
-	proj, err := client.CreateProject(&pb.CreateProjectRequest{
-		Name:        projectName,
-		DisplayName: projectName,
-		Description: &desc,
-		GitRemote:   optionalString(gitRemote),
-		Metadata:    map[string]string{},
-	})
+func detectGitRemote(projectPath string) string {
+	cmd := exec.Command("git", "-C", projectPath, "config", "--get", "remote.origin.url")
+	output, err := cmd.Output()
 	if err != nil {
-		return nil, err
+		return ""
 	}
-	return proj, nil
+	return strings.TrimSpace(string(output))
 }
 
-func detectGitRemote(projectPath string) string {
-	cmd := exec.Command("git", "-C", projectPath, "config", "--get", "remote.origin.url")
+func detectGitTopLevel(projectPath string) string {
+	cmd := exec.Command("git", "-C", projectPath, "rev-parse", "--show-toplevel")
 	output, err := cmd.Output()
 	if err != nil {
 		return ""
@@ -1401,6 +1790,24 @@ func (c *apiClient) UpsertSession(req *pb.CreateSessionRequest, timeout time.Dur
 	return c.client.UpsertSession(ctx, req)
 }
 
+func (c *apiClient) GetSession(sessionID string, timeout time.Duration) (*pb.Session, error) {
+	ctx, cancel := c.authContext(timeout)
+	defer cancel()
+	return c.client.GetSession(ctx, &pb.GetSessionRequest{SessionId: sessionID})
+}
+
+func (c *apiClient) UpdateSession(req *pb.UpdateSessionRequest, timeout time.Duration) (*pb.Session, error) {
+	ctx, cancel := c.authContext(timeout)
+	defer cancel()
+	return c.client.UpdateSession(ctx, req)
+}
+
+func (c *apiClient) ListSessions(req *pb.ListSessionsRequest, timeout time.Duration) (*pb.ListSessionsResponse, error) {
+	ctx, cancel := c.authContext(timeout)
+	defer cancel()
+	return c.client.ListSessions(ctx, req)
+}
+
 func (c *apiClient) GetProjectObservations(projectID string, limit int32, timeout time.Duration) (*pb.GetProjectObservationsResponse, error) {
 	ctx, cancel := c.authContext(timeout)
 	defer cancel()
@@ -1446,6 +1853,12 @@ func (c *apiClient) CreateTeamSkill(req *pb.CreateTeamSkillRequest, timeout time
 	return c.client.CreateTeamSkill(ctx, req)
 }
 
+func (c *apiClient) UpdateTeamSkill(req *pb.UpdateTeamSkillRequest, timeout time.Duration) (*pb.UpdateTeamSkillResponse, error) {
+	ctx, cancel := c.authContext(timeout)
+	defer cancel()
+	return c.client.UpdateTeamSkill(ctx, req)
+}
+
 func readHookInput() hookInput {
 	stdinInfo, err := os.Stdin.Stat()
 	if err != nil {
@@ -1467,7 +1880,10 @@ func readHookInput() hookInput {
 	return input
 }
 
-func appendProjectDirToEnv(projectDir string) {
+// appendSessionEnv exports the project dir and, when known, the session ID
+// into the Claude Code session's shell environment. The prepare-commit-msg
+// hook from "git install-hook" reads SESSIONHUB_SESSION_ID.
+func appendSessionEnv(projectDir, sessionID string) {
 	envFile := strings.TrimSpace(os.Getenv("CLAUDE_ENV_FILE"))
 	if envFile == "" || projectDir == "" {
 		return
@@ -1481,6 +1897,9 @@ func appendProjectDirToEnv(projectDir string) {
 
 	escaped := escapeShellString(projectDir)
 	_, _ = f.WriteString(fmt.Sprintf("export SESSIONHUB_PROJECT_DIR=\"%s\"\n", escaped))
+	if uuidPattern.MatchString(sessionID) {
+		_, _ = f.WriteString(fmt.Sprintf("export SESSIONHUB_SESSION_ID=\"%s\"\n", sessionID))
+	}
 }
 
 func escapeShellString(v string) string {
@@ -1735,6 +2154,23 @@ func parseFrontmatter(content string) (body, name, description string) {
 	return body, name, description
 }
 
+func slugify(input string) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(input)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && sb.Len() > 0 {
+			sb.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "-")
+}
+
 func titleCase(input string) string {
 	input = strings.ReplaceAll(input, "-", " ")
 	input = strings.ReplaceAll(input, "_", " ")
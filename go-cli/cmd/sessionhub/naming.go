@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const (
+	maxSessionNameLength = 80
+	// Long branch names are shortened so the title keeps most of the name.
+	maxBranchSuffixLength = 30
+	minSessionTitleLength = 40
+)
+
+var (
+	codeFenceRegex    = regexp.MustCompile("(?s)```.*?(```|$)")
+	inlineMarkupRegex = regexp.MustCompile("[`*_#>\\[\\]]+")
+	urlRegex          = regexp.MustCompile(`https?://\S+`)
+
+	// Prompts that only steer an ongoing conversation say nothing about
+	// what the session is for.
+	trivialPrompts = map[string]bool{
+		"yes": true, "y": true, "no": true, "n": true, "ok": true, "okay": true,
+		"continue": true, "go on": true, "go ahead": true, "proceed": true,
+		"thanks": true, "thank you": true, "done": true, "next": true,
+		"try again": true, "retry": true, "do it": true, "sure": true,
+		"lgtm": true, "looks good": true, "sounds good": true,
+	}
+
+	// Lead-ins that add nothing to a title.
+	promptLeadIns = []string{"please ", "can you ", "could you ", "would you ", "i want you to ", "i'd like you to ", "help me ", "let's ", "lets "}
+
+	// Edit tools whose file_path (or notebook_path) counts as a touched file.
+	fileEditTools = map[string]bool{"Edit": true, "MultiEdit": true, "Write": true, "NotebookEdit": true}
+)
+
+// deriveSessionName builds a dashboard title for a parsed transcript. In order
+// of preference it uses Claude Code's own summary entry, the first substantive
+// prompt, or the files the session edited; non-default branches are appended,
+// and a transcript with none of those is named after its start time.
+func deriveSessionName(parsed *parsedSession) string {
+	title := sanitizeSessionTitle(parsed.Summary)
+	if title == "" {
+		title = sanitizeSessionTitle(parsed.FirstPrompt)
+	}
+	if title == "" {
+		if files := touchedFiles(parsed); len(files) > 0 {
+			names := make([]string, 0, 3)
+			for _, f := range files {
+				if len(names) == 3 {
+					break
+				}
+				names = append(names, filepath.Base(f))
+			}
+			title = "Edit " + strings.Join(names, ", ")
+			if len(files) > len(names) {
+				title += fmt.Sprintf(" (+%d more)", len(files)-len(names))
+			}
+		}
+	}
+
+	branch := strings.TrimSpace(parsed.GitBranch)
+	if branch == "main" || branch == "master" || branch == "HEAD" {
+		branch = ""
+	}
+	if title == "" {
+		title = "Session - " + formatSessionTime(parsed.StartTime)
+	}
+	if branch != "" {
+		suffix := " [" + truncateTitle(branch, maxBranchSuffixLength) + "]"
+		return truncateTitle(title, max(maxSessionNameLength-len([]rune(suffix)), minSessionTitleLength)) + suffix
+	}
+	return truncateTitle(title, maxSessionNameLength)
+}
+
+// isSubstantivePrompt reports whether a prompt describes work rather than
+// steering the conversation (confirmations, slash commands and the like).
+func isSubstantivePrompt(prompt string) bool {
+	p := strings.TrimSpace(prompt)
+	if p == "" || strings.HasPrefix(p, "/") || strings.HasPrefix(p, "<") {
+		return false
+	}
+	// Claude Code opens a compacted conversation with a generated recap.
+	if strings.HasPrefix(p, "This session is being continued from a previous conversation") {
+		return false
+	}
+	normalized := strings.ToLower(strings.TrimRight(p, ".!? "))
+	if trivialPrompts[normalized] {
+		return false
+	}
+	return len(strings.Fields(sanitizeSessionTitle(p))) >= 2
+}
+
+// sanitizeSessionTitle reduces free text to its first sentence on a single
+// line, without code, URLs or markdown markup.
+func sanitizeSessionTitle(text string) string {
+	text = codeFenceRegex.ReplaceAllString(text, " ")
+	text = urlRegex.ReplaceAllString(text, " ")
+	for _, line := range strings.Split(text, "\n") {
+		line = inlineMarkupRegex.ReplaceAllString(line, "")
+		line = strings.Map(func(r rune) rune {
+			if unicode.IsControl(r) {
+				return ' '
+			}
+			return r
+		}, line)
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			continue
+		}
+		if i := strings.Index(line, ". "); i > 0 {
+			line = line[:i]
+		}
+		for _, lead := range promptLeadIns {
+			if len(line) > len(lead) && strings.EqualFold(line[:len(lead)], lead) {
+				line = line[len(lead):]
+				break
+			}
+		}
+		line = strings.TrimRight(line, ".:;,?! ")
+		if line == "" {
+			continue
+		}
+		r := []rune(line)
+		r[0] = unicode.ToUpper(r[0])
+		return string(r)
+	}
+	return ""
+}
+
+// truncateTitle shortens s to at most n runes, cutting at a word boundary when
+// one is reasonably close.
+func truncateTitle(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	if n < 2 {
+		return string(r[:max(n, 0)])
+	}
+	cut := string(r[:n-1])
+	if i := strings.LastIndex(cut, " "); i > len(cut)/2 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, ".,:;- ") + "…"
+}
+
+// touchedFiles lists the files edited in a session, in first-touched order,
+// relative to the session's working directory where possible.
+func touchedFiles(parsed *parsedSession) []string {
+	seen := map[string]bool{}
+	files := make([]string, 0)
+	for _, use := range parsed.ToolUses {
+		if !fileEditTools[use.Name] {
+			continue
+		}
+		p := coalesce(asString(use.Input["file_path"]), asString(use.Input["notebook_path"]))
+		if p == "" {
+			continue
+		}
+		if parsed.Cwd != "" {
+			if rel, err := filepath.Rel(parsed.Cwd, p); err == nil && !strings.HasPrefix(rel, "..") {
+				p = rel
+			}
+		}
+		if !seen[p] {
+			seen[p] = true
+			files = append(files, p)
+		}
+	}
+	return files
+}
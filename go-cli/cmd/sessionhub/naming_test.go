@@ -0,0 +1,29 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDeriveSessionNameLongBranch(t *testing.T) {
+	parsed := &parsedSession{
+		FirstPrompt: "Refactor the session importer so uploads run in parallel",
+		GitBranch:   "feature/" + strings.Repeat("x", 80),
+		StartTime:   "2025-01-02T03:04:05Z",
+	}
+	name := deriveSessionName(parsed)
+	if n := len([]rune(name)); n > maxSessionNameLength {
+		t.Fatalf("name is %d runes, want at most %d: %q", n, maxSessionNameLength, name)
+	}
+	if !strings.HasPrefix(name, "Refactor") || !strings.HasSuffix(name, "…]") {
+		t.Fatalf("unexpected name %q", name)
+	}
+}
+
+func TestTruncateTitleTinyLimit(t *testing.T) {
+	for _, n := range []int{-5, 0, 1} {
+		if got := truncateTitle("abcdef", n); len([]rune(got)) > max(n, 0) {
+			t.Errorf("truncateTitle(%d) = %q", n, got)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// modelPricing is the price of a model in US dollars per million tokens.
+// Cache writes are the five-minute cache write price.
+type modelPricing struct {
+	Input      float64 `json:"input"`
+	Output     float64 `json:"output"`
+	CacheWrite float64 `json:"cacheWrite"`
+	CacheRead  float64 `json:"cacheRead"`
+}
+
+// pricingTable is keyed by model name. A key also covers the model's dated
+// snapshots, so "claude-sonnet-4-5" prices "claude-sonnet-4-5-20250929"; any
+// other name must be listed itself, so a newer model is never priced at an
+// older one's rates.
+type pricingTable map[string]modelPricing
+
+var snapshotSuffixRegex = regexp.MustCompile(`-\d{8}$`)
+
+// defaultPricing is Anthropic's published list pricing. Models missing here,
+// or priced differently for an account, can be set under "pricing" in config.
+var defaultPricing = pricingTable{
+	"claude-opus-4-5":   {Input: 5, Output: 25, CacheWrite: 6.25, CacheRead: 0.50},
+	"claude-opus-4-1":   {Input: 15, Output: 75, CacheWrite: 18.75, CacheRead: 1.50},
+	"claude-opus-4":     {Input: 15, Output: 75, CacheWrite: 18.75, CacheRead: 1.50},
+	"claude-opus-4-0":   {Input: 15, Output: 75, CacheWrite: 18.75, CacheRead: 1.50},
+	"claude-sonnet-4-5": {Input: 3, Output: 15, CacheWrite: 3.75, CacheRead: 0.30},
+	"claude-sonnet-4":   {Input: 3, Output: 15, CacheWrite: 3.75, CacheRead: 0.30},
+	"claude-sonnet-4-0": {Input: 3, Output: 15, CacheWrite: 3.75, CacheRead: 0.30},
+	"claude-3-7-sonnet": {Input: 3, Output: 15, CacheWrite: 3.75, CacheRead: 0.30},
+	"claude-3-5-sonnet": {Input: 3, Output: 15, CacheWrite: 3.75, CacheRead: 0.30},
+	"claude-haiku-4-5":  {Input: 1, Output: 5, CacheWrite: 1.25, CacheRead: 0.10},
+	"claude-3-5-haiku":  {Input: 0.80, Output: 4, CacheWrite: 1, CacheRead: 0.08},
+	"claude-3-opus":     {Input: 15, Output: 75, CacheWrite: 18.75, CacheRead: 1.50},
+	"claude-3-haiku":    {Input: 0.25, Output: 1.25, CacheWrite: 0.30, CacheRead: 0.03},
+}
+
+// pricingTableFromConfig overlays the "pricing" config entries on the
+// defaults:
+// "pricing": {"claude-sonnet-4-5": {"input": 3, "output": 15, "cacheWrite": 3.75, "cacheRead": 0.3}}.
+func pricingTableFromConfig(cfg config) pricingTable {
+	table := make(pricingTable, len(defaultPricing)+len(cfg.Pricing))
+	for model, p := range defaultPricing {
+		table[model] = p
+	}
+	for model, p := range cfg.Pricing {
+		table[strings.ToLower(strings.TrimSpace(model))] = p
+	}
+	return table
+}
+
+func (t pricingTable) lookup(model string) (modelPricing, bool) {
+	model = strings.ToLower(model)
+	if p, ok := t[model]; ok {
+		return p, true
+	}
+	p, ok := t[snapshotSuffixRegex.ReplaceAllString(model, "")]
+	return p, ok
+}
+
+// costEstimate is what a session's token usage would cost at the table's
+// prices. Models without a price are listed in Unpriced and left out of the
+// total.
+type costEstimate struct {
+	TotalUSD float64            `json:"totalUsd"`
+	ByModel  map[string]float64 `json:"byModel"`
+	Unpriced []string           `json:"unpricedModels,omitempty"`
+}
+
+func estimateCost(byModel map[string]tokenUsage, table pricingTable) costEstimate {
+	estimate := costEstimate{ByModel: map[string]float64{}}
+	for model, usage := range byModel {
+		p, ok := table.lookup(model)
+		if !ok {
+			estimate.Unpriced = append(estimate.Unpriced, model)
+			continue
+		}
+		cost := (float64(usage.InputTokens)*p.Input +
+			float64(usage.OutputTokens)*p.Output +
+			float64(usage.CacheCreateTokens)*p.CacheWrite +
+			float64(usage.CacheReadTokens)*p.CacheRead) / 1e6
+		estimate.ByModel[model] = roundCost(cost)
+		estimate.TotalUSD += cost
+	}
+	estimate.TotalUSD = roundCost(estimate.TotalUSD)
+	sort.Strings(estimate.Unpriced)
+	return estimate
+}
+
+func roundCost(usd float64) float64 {
+	return math.Round(usd*1e4) / 1e4
+}
+
+// addCostMetadata records the estimate as "estimated_cost_usd", with the
+// per-model amounts as a JSON object in "cost_by_model" and models without a
+// price in "unpriced_models". There is no estimate when no model was priced.
+func addCostMetadata(md map[string]string, estimate costEstimate) {
+	if len(estimate.Unpriced) > 0 {
+		md["unpriced_models"] = strings.Join(estimate.Unpriced, ",")
+	}
+	if len(estimate.ByModel) == 0 {
+		return
+	}
+	md["estimated_cost_usd"] = strconv.FormatFloat(estimate.TotalUSD, 'f', 4, 64)
+	if b, err := json.Marshal(estimate.ByModel); err == nil {
+		md["cost_by_model"] = string(b)
+	}
+}
@@ -0,0 +1,27 @@
+package main
+
+import "testing"
+
+func TestPricingLookup(t *testing.T) {
+	table := pricingTableFromConfig(config{})
+	cases := map[string]bool{
+		"claude-opus-4-5-20251101":   true,
+		"claude-opus-4-20250514":     true,
+		"claude-sonnet-4-5":          true,
+		"claude-opus-4-6":            false,
+		"claude-opus-4-5-preview":    false,
+		"claude-sonnet-4-5-20250929": true,
+	}
+	for model, want := range cases {
+		if _, ok := table.lookup(model); ok != want {
+			t.Errorf("lookup(%q) priced = %v, want %v", model, ok, want)
+		}
+	}
+	if p, _ := table.lookup("claude-opus-4-5-20251101"); p.Output != 25 {
+		t.Errorf("claude-opus-4-5 output price = %v, want 25", p.Output)
+	}
+	estimate := estimateCost(map[string]tokenUsage{"claude-opus-4-6": {OutputTokens: 1e6}}, table)
+	if len(estimate.Unpriced) != 1 || estimate.TotalUSD != 0 {
+		t.Errorf("unknown model should be unpriced: %+v", estimate)
+	}
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pb "github.com/sessionhuborg/plugin/go-cli/proto"
+)
+
+// projectsCacheEntry remembers which SessionHub project a local checkout was
+// mapped to, so later captures skip the lookup and survive project renames.
+type projectsCacheEntry struct {
+	ProjectID   string `json:"projectId"`
+	ProjectName string `json:"projectName"`
+	GitRemote   string `json:"gitRemote,omitempty"`
+}
+
+type projectsCache struct {
+	Paths map[string]projectsCacheEntry `json:"paths"`
+}
+
+func projectsCachePath() string {
+	return filepath.Join(filepath.Dir(configPath()), "projects-cache.json")
+}
+
+func loadProjectsCache() projectsCache {
+	cache := projectsCache{}
+	if data, err := os.ReadFile(projectsCachePath()); err == nil {
+		_ = json.Unmarshal(data, &cache)
+	}
+	if cache.Paths == nil {
+		cache.Paths = map[string]projectsCacheEntry{}
+	}
+	return cache
+}
+
+func saveProjectsCache(cache projectsCache) error {
+	path := projectsCachePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	payload, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := fmt.Sprintf("%s.%d.tmp", path, os.Getpid())
+	if err := os.WriteFile(tmp, payload, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// normalizeGitRemote reduces the many spellings of a remote URL to
+// "host/owner/repo" in lower case, so that
+// git@github.com:Org/api.git and https://github.com/org/api compare equal.
+// Local paths are returned cleaned but otherwise unchanged.
+func normalizeGitRemote(remote string) string {
+	r := strings.TrimSpace(remote)
+	if r == "" {
+		return ""
+	}
+	if _, rest, ok := strings.Cut(r, "://"); ok {
+		host, repoPath, _ := strings.Cut(rest, "/")
+		if at := strings.LastIndex(host, "@"); at >= 0 {
+			host = host[at+1:]
+		}
+		if h, _, hasPort := strings.Cut(host, ":"); hasPort {
+			host = h
+		}
+		r = host + "/" + repoPath
+	} else if colon := strings.Index(r, ":"); colon > 0 && !strings.Contains(r[:colon], "/") {
+		// scp-like syntax: [user@]host:owner/repo
+		host := r[:colon]
+		if at := strings.LastIndex(host, "@"); at >= 0 {
+			host = host[at+1:]
+		}
+		r = host + "/" + strings.TrimPrefix(r[colon+1:], "/")
+	} else {
+		return filepath.Clean(r)
+	}
+	r = strings.TrimSuffix(strings.TrimSuffix(r, "/"), ".git")
+	return strings.ToLower(r)
+}
+
+// projectRemote returns a project's normalized remote, falling back to its
+// GitHub connection.
+func projectRemote(p *pb.Project) string {
+	if remote := normalizeGitRemote(p.GetGitRemote()); remote != "" {
+		return remote
+	}
+	if p.GetGithubRepoOwner() != "" && p.GetGithubRepoName() != "" {
+		return strings.ToLower("github.com/" + p.GetGithubRepoOwner() + "/" + p.GetGithubRepoName())
+	}
+	return ""
+}
+
+// matchProject finds the existing project for a checkout without creating
+// anything. It tries, in order, the local path cache, the normalized git
+// remote, and the directory name. A project with the same name but a
+// different remote is not a match; nameTaken reports that case so a new
+// project can be given a distinct name.
+func matchProject(projects []*pb.Project, cache projectsCache, projectPath, remote string) (project *pb.Project, nameTaken bool) {
+	byID := make(map[string]*pb.Project, len(projects))
+	for _, p := range projects {
+		byID[p.GetId()] = p
+	}
+	if entry, ok := cache.Paths[projectPath]; ok && (entry.GitRemote == "" || remote == "" || entry.GitRemote == remote) {
+		if p := byID[entry.ProjectID]; p != nil {
+			return p, false
+		}
+	}
+
+	name := filepath.Base(projectPath)
+	if remote != "" {
+		var first *pb.Project
+		for _, p := range projects {
+			if projectRemote(p) != remote {
+				continue
+			}
+			if p.GetName() == name || p.GetDisplayName() == name {
+				return p, false
+			}
+			if first == nil && p.GetMetadata()[subprojectPathKey] == "" {
+				first = p
+			}
+		}
+		if first != nil {
+			return first, false
+		}
+	}
+
+	for _, p := range projects {
+		if p.GetName() != name && p.GetDisplayName() != name {
+			continue
+		}
+		if pr := projectRemote(p); remote == "" || pr == "" || pr == remote {
+			return p, false
+		}
+		nameTaken = true
+	}
+	return nil, nameTaken
+}
+
+// ensureProject resolves the SessionHub project for a checkout, creating it if
+// needed, and records the mapping in the local path cache. Linked worktrees
+// resolve to their main repository's project. An explicit name (from
+// --project) bypasses remote matching and is not cached.
+func ensureProject(client *apiClient, projectPath, explicitName string) (*pb.Project, error) {
+	projectPath = repositoryPath(projectPath)
+	projects, err := client.GetProjects(20 * time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	rawRemote := detectGitRemote(projectPath)
+	remote := normalizeGitRemote(rawRemote)
+	cache := loadProjectsCache()
+
+	var project *pb.Project
+	name := explicitName
+	if name != "" {
+		project = findProjectByName(projects, name)
+	} else {
+		var nameTaken bool
+		project, nameTaken = matchProject(projects, cache, projectPath, remote)
+		name = filepath.Base(projectPath)
+		if nameTaken {
+			// Another checkout with the same directory name belongs to a
+			// different repository; qualify the name with the repo owner.
+			parts := strings.Split(remote, "/")
+			if len(parts) >= 2 {
+				name = fmt.Sprintf("%s-%s", name, parts[len(parts)-2])
+			}
+		}
+	}
+
+	if project == nil {
+		desc := fmt.Sprintf("Auto-created project from CLI for %s", name)
+		project, err = client.CreateProject(&pb.CreateProjectRequest{
+			Name:        name,
+			DisplayName: name,
+			Description: &desc,
+			GitRemote:   optionalString(rawRemote),
+			Metadata:    map[string]string{},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// An explicit name is a one-off choice; caching it would send every
+	// later default capture of this checkout to the same project.
+	if explicitName == "" {
+		cache.Paths[projectPath] = projectsCacheEntry{ProjectID: project.GetId(), ProjectName: project.GetName(), GitRemote: remote}
+		_ = saveProjectsCache(cache)
+	}
+	return project, nil
+}
+
+// findProjectForPath is the read-only counterpart of ensureProject, used by
+// commands that look a project up from the current directory.
+func findProjectForPath(projects []*pb.Project, projectPath string) *pb.Project {
+	projectPath = repositoryPath(projectPath)
+	project, _ := matchProject(projects, loadProjectsCache(), projectPath, normalizeGitRemote(detectGitRemote(projectPath)))
+	return project
+}
@@ -0,0 +1,532 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/sessionhuborg/plugin/go-cli/proto"
+)
+
+func runSessions(args []string) int {
+	if len(args) < 1 {
+		fmt.Fprintln(os.Stderr, "usage: sessionhub sessions list|show|rename|retype|tag|close [flags]")
+		return 2
+	}
+
+	switch args[0] {
+	case "list":
+		return runSessionsList(args[1:])
+	case "show":
+		return runSessionsShow(args[1:])
+	case "rename", "retype", "tag", "close":
+		return runSessionsUpdate(args[0], args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "unknown sessions subcommand: %s\n", args[0])
+		return 2
+	}
+}
+
+func runSessionsList(args []string) int {
+	fsFlags := flag.NewFlagSet("sessions list", flag.ContinueOnError)
+	projectName := fsFlags.String("project", "", "Project name (default: project for the current directory)")
+	allProjects := fsFlags.Bool("all-projects", false, "List sessions from every project")
+	branch := fsFlags.String("branch", "", "Only sessions on this git branch")
+	since := fsFlags.String("since", "", "Only sessions started at or after this time (RFC 3339, YYYY-MM-DD, or a duration like 7d)")
+	until := fsFlags.String("until", "", "Only sessions started before this time (RFC 3339, YYYY-MM-DD inclusive, or a duration like 24h)")
+	limit := fsFlags.Int("limit", 20, "Max sessions to list")
+	apiKeyOverride := fsFlags.String("api-key", "", "API key override")
+	jsonOutput := fsFlags.Bool("json", false, "Emit JSON output")
+	if err := fsFlags.Parse(args); err != nil {
+		return 2
+	}
+
+	now := time.Now()
+	req := &pb.ListSessionsRequest{GitBranch: optionalString(strings.TrimSpace(*branch))}
+	if strings.TrimSpace(*since) != "" {
+		t, err := parseTimeBound(*since, now, false)
+		if err != nil {
+			return emitError(fmt.Errorf("invalid --since: %w", err), *jsonOutput)
+		}
+		req.StartAfter = stringPtr(t.UTC().Format(time.RFC3339))
+	}
+	if strings.TrimSpace(*until) != "" {
+		t, err := parseTimeBound(*until, now, true)
+		if err != nil {
+			return emitError(fmt.Errorf("invalid --until: %w", err), *jsonOutput)
+		}
+		req.StartBefore = stringPtr(t.UTC().Format(time.RFC3339))
+	}
+
+	_, client, _, err := initializeAuthenticatedClient(*apiKeyOverride, 15*time.Second)
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+	defer client.Close()
+
+	projects, err := client.GetProjects(15 * time.Second)
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+	projectNames := make(map[string]string, len(projects))
+	for _, p := range projects {
+		projectNames[p.GetId()] = coalesce(p.GetDisplayName(), p.GetName())
+	}
+
+	resolvedProjectName := ""
+	if !*allProjects {
+		resolvedProjectName = strings.TrimSpace(*projectName)
+		var project *pb.Project
+		if resolvedProjectName != "" {
+			project = findProjectByName(projects, resolvedProjectName)
+		} else if cwd, cwdErr := os.Getwd(); cwdErr == nil {
+			resolvedProjectName = filepath.Base(cwd)
+			project = findProjectForPath(projects, cwd)
+		}
+		if project == nil {
+			return emitError(fmt.Errorf("project not found: %s (use --project or --all-projects)", resolvedProjectName), *jsonOutput)
+		}
+		resolvedProjectName = project.GetName()
+		req.ProjectId = stringPtr(project.GetId())
+	}
+
+	wanted := max(*limit, 1)
+	sessions := make([]*pb.Session, 0, wanted)
+	for len(sessions) < wanted {
+		req.Limit = int32(wanted - len(sessions))
+		resp, listErr := client.ListSessions(req, 20*time.Second)
+		if listErr != nil {
+			return emitError(listErr, *jsonOutput)
+		}
+		sessions = append(sessions, resp.GetSessions()...)
+		if resp.GetNextPageToken() == "" || len(resp.GetSessions()) == 0 {
+			break
+		}
+		req.PageToken = stringPtr(resp.GetNextPageToken())
+	}
+	if len(sessions) > wanted {
+		sessions = sessions[:wanted]
+	}
+
+	if !*jsonOutput {
+		if len(sessions) == 0 {
+			fmt.Println("No sessions found")
+			return 0
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		if *allProjects {
+			fmt.Fprintln(tw, "ID\tSTARTED\tPROJECT\tBRANCH\tINTERACTIONS\tTOKENS\tNAME")
+		} else {
+			fmt.Fprintln(tw, "ID\tSTARTED\tBRANCH\tINTERACTIONS\tTOKENS\tNAME")
+		}
+		for _, s := range sessions {
+			cols := []string{s.GetId(), formatSessionTime(s.GetStartTime())}
+			if *allProjects {
+				cols = append(cols, coalesce(projectNames[s.GetProjectId()], s.GetProjectId()))
+			}
+			cols = append(cols,
+				coalesce(s.GetGitBranch(), "-"),
+				strconv.Itoa(int(s.GetInteractionCount())),
+				formatTokenCount(s.GetInputTokens()+s.GetOutputTokens()),
+				truncateRunes(coalesce(s.GetName(), "(unnamed)"), 60),
+			)
+			fmt.Fprintln(tw, strings.Join(cols, "\t"))
+		}
+		_ = tw.Flush()
+		return 0
+	}
+
+	items := make([]map[string]any, 0, len(sessions))
+	for _, s := range sessions {
+		items = append(items, sessionPayload(s, projectNames[s.GetProjectId()]))
+	}
+	payload := map[string]any{
+		"success":    true,
+		"totalCount": len(items),
+		"sessions":   items,
+	}
+	if resolvedProjectName != "" {
+		payload["project"] = resolvedProjectName
+	}
+	return emitJSONOrPretty(payload, true)
+}
+
+func runSessionsShow(args []string) int {
+	fsFlags := flag.NewFlagSet("sessions show", flag.ContinueOnError)
+	sessionID := fsFlags.String("id", "", "Session ID (default: the last captured session)")
+	apiKeyOverride := fsFlags.String("api-key", "", "API key override")
+	jsonOutput := fsFlags.Bool("json", false, "Emit JSON output")
+	positional, err := parseInterspersed(fsFlags, args)
+	if err != nil {
+		return 2
+	}
+
+	id, err := resolveSessionIDArg(*sessionID, positional)
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+
+	_, client, _, err := initializeAuthenticatedClient(*apiKeyOverride, 15*time.Second)
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+	defer client.Close()
+
+	s, err := client.GetSession(id, 20*time.Second)
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+
+	projectName := ""
+	if projects, projErr := client.GetProjects(15 * time.Second); projErr == nil {
+		for _, p := range projects {
+			if p.GetId() == s.GetProjectId() {
+				projectName = coalesce(p.GetDisplayName(), p.GetName())
+				break
+			}
+		}
+	}
+
+	if *jsonOutput {
+		payload := sessionPayload(s, projectName)
+		payload["success"] = true
+		return emitJSONOrPretty(payload, true)
+	}
+	printSessionDetails(s, projectName)
+	return 0
+}
+
+// sessionTypes are the values the backend accepts for a session's type.
+var sessionTypes = []string{"feature", "bugfix", "refactor", "exploration", "debugging"}
+
+func isSessionType(v string) bool {
+	for _, t := range sessionTypes {
+		if t == v {
+			return true
+		}
+	}
+	return false
+}
+
+// runSessionsUpdate implements the sessions subcommands that edit a session:
+//
+//	rename <id> <name>
+//	retype <id> <type>
+//	tag <id> <a,b,...> | tag <id> --clear
+//	close [<id>] [--at <time>]
+//
+// The ID may be "last" for the most recently captured session.
+func runSessionsUpdate(action string, args []string) int {
+	fsFlags := flag.NewFlagSet("sessions "+action, flag.ContinueOnError)
+	at := fsFlags.String("at", "", "End time for close (RFC 3339; default: now)")
+	clearTags := fsFlags.Bool("clear", false, "Remove all tags (tag only)")
+	apiKeyOverride := fsFlags.String("api-key", "", "API key override")
+	jsonOutput := fsFlags.Bool("json", false, "Emit JSON output")
+	positional, err := parseInterspersed(fsFlags, args)
+	if err != nil {
+		return 2
+	}
+
+	var id string
+	req := &pb.UpdateSessionRequest{}
+	var summary string
+	if action == "close" {
+		id, err = resolveSessionIDArg("", positional)
+		if err != nil {
+			return emitError(err, *jsonOutput)
+		}
+		endTime := time.Now().UTC()
+		if strings.TrimSpace(*at) != "" {
+			endTime, err = time.Parse(time.RFC3339, strings.TrimSpace(*at))
+			if err != nil {
+				return emitError(fmt.Errorf("invalid --at: %w", err), *jsonOutput)
+			}
+		}
+		req.EndTime = stringPtr(endTime.UTC().Format(time.RFC3339))
+		summary = "Closed session at " + formatSessionTime(req.GetEndTime())
+	} else {
+		if len(positional) < 2 && !(action == "tag" && len(positional) == 1) {
+			return emitError(fmt.Errorf("usage: sessionhub sessions %s <id> <value>", action), *jsonOutput)
+		}
+		id, err = resolveSessionIDArg("", positional[:1])
+		if err != nil {
+			return emitError(err, *jsonOutput)
+		}
+		value := strings.TrimSpace(strings.Join(positional[1:], " "))
+		switch action {
+		case "rename":
+			if value == "" {
+				return emitError(errors.New("session name cannot be empty"), *jsonOutput)
+			}
+			req.Name = stringPtr(value)
+			summary = fmt.Sprintf("Renamed session to %q", value)
+		case "retype":
+			value = strings.ToLower(value)
+			if !isSessionType(value) {
+				return emitError(fmt.Errorf("invalid session type %q (expected one of: %s)", value, strings.Join(sessionTypes, ", ")), *jsonOutput)
+			}
+			req.Type = stringPtr(value)
+			summary = "Set session type to " + value
+		case "tag":
+			tags := make([]string, 0)
+			for _, t := range strings.Split(value, ",") {
+				if t = strings.TrimSpace(t); t != "" {
+					tags = append(tags, t)
+				}
+			}
+			// Replacing the tags with nothing has to be asked for, so a
+			// forgotten argument does not wipe them.
+			if len(tags) == 0 && !*clearTags {
+				return emitError(errors.New("no tags given; pass --clear to remove all tags"), *jsonOutput)
+			}
+			if len(tags) > 0 && *clearTags {
+				return emitError(errors.New("use either tags or --clear, not both"), *jsonOutput)
+			}
+			req.Tags = tags
+			req.UpdateTags = true
+			if len(tags) == 0 {
+				summary = "Cleared session tags"
+			} else {
+				summary = "Set session tags to " + strings.Join(tags, ", ")
+			}
+		}
+	}
+	req.SessionId = id
+
+	_, client, _, err := initializeAuthenticatedClient(*apiKeyOverride, 15*time.Second)
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+	defer client.Close()
+
+	s, err := client.UpdateSession(req, 20*time.Second)
+	if err != nil {
+		return emitError(err, *jsonOutput)
+	}
+
+	if *jsonOutput {
+		payload := sessionPayload(s, "")
+		payload["success"] = true
+		payload["message"] = summary
+		return emitJSONOrPretty(payload, true)
+	}
+	fmt.Printf("%s: %s (%s)\n", summary, coalesce(s.GetName(), "(unnamed)"), s.GetId())
+	return 0
+}
+
+// parseInterspersed parses flags that may appear before, between or after
+// positional arguments, and returns the positional arguments in order.
+func parseInterspersed(fsFlags *flag.FlagSet, args []string) ([]string, error) {
+	positional := make([]string, 0)
+	for {
+		if err := fsFlags.Parse(args); err != nil {
+			return nil, err
+		}
+		if fsFlags.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fsFlags.Arg(0))
+		args = fsFlags.Args()[1:]
+	}
+}
+
+// resolveSessionIDArg takes the session ID from --id, the first positional
+// argument, or the last captured session, in that order.
+func resolveSessionIDArg(flagValue string, positional []string) (string, error) {
+	id := strings.TrimSpace(flagValue)
+	if id == "" && len(positional) > 0 {
+		id = strings.TrimSpace(positional[0])
+	}
+	if id == "" || id == "last" {
+		last, err := loadLastSession()
+		if err != nil || strings.TrimSpace(last.SessionID) == "" {
+			return "", errors.New("session ID is required (no session has been captured yet)")
+		}
+		id = last.SessionID
+	}
+	return id, nil
+}
+
+func findProjectByName(projects []*pb.Project, name string) *pb.Project {
+	for _, p := range projects {
+		if p.GetName() == name || p.GetDisplayName() == name {
+			return p
+		}
+	}
+	return nil
+}
+
+func sessionPayload(s *pb.Session, projectName string) map[string]any {
+	todoSnapshots := make([]map[string]any, 0, len(s.GetTodoSnapshots()))
+	for _, snap := range s.GetTodoSnapshots() {
+		todos := make([]map[string]any, 0, len(snap.GetTodos()))
+		for _, t := range snap.GetTodos() {
+			todos = append(todos, map[string]any{"content": t.GetContent(), "status": t.GetStatus(), "activeForm": t.GetActiveForm()})
+		}
+		todoSnapshots = append(todoSnapshots, map[string]any{"timestamp": snap.GetTimestamp(), "todos": todos})
+	}
+	attachments := make([]map[string]any, 0, len(s.GetAttachmentUrls()))
+	for _, a := range s.GetAttachmentUrls() {
+		attachments = append(attachments, map[string]any{
+			"storagePath":      a.GetStoragePath(),
+			"publicUrl":        a.GetPublicUrl(),
+			"mediaType":        a.GetMediaType(),
+			"interactionIndex": a.GetInteractionIndex(),
+			"uploadedAt":       a.GetUploadedAt(),
+		})
+	}
+	metadata := s.GetMetadata()
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	payload := map[string]any{
+		"id":                s.GetId(),
+		"projectId":         s.GetProjectId(),
+		"name":              s.GetName(),
+		"gitBranch":         s.GetGitBranch(),
+		"type":              s.GetType(),
+		"tags":              s.GetTags(),
+		"startTime":         s.GetStartTime(),
+		"endTime":           s.GetEndTime(),
+		"interactionCount":  s.GetInteractionCount(),
+		"inputTokens":       s.GetInputTokens(),
+		"outputTokens":      s.GetOutputTokens(),
+		"cacheCreateTokens": s.GetCacheCreateTokens(),
+		"cacheReadTokens":   s.GetCacheReadTokens(),
+		"todoSnapshots":     todoSnapshots,
+		"attachments":       attachments,
+		"metadata":          metadata,
+		"createdAt":         s.GetCreatedAt(),
+		"updatedAt":         s.GetUpdatedAt(),
+	}
+	if projectName != "" {
+		payload["projectName"] = projectName
+	}
+	return payload
+}
+
+func printSessionDetails(s *pb.Session, projectName string) {
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Name:\t%s\n", coalesce(s.GetName(), "(unnamed)"))
+	fmt.Fprintf(tw, "ID:\t%s\n", s.GetId())
+	fmt.Fprintf(tw, "Project:\t%s\n", coalesce(projectName, s.GetProjectId()))
+	fmt.Fprintf(tw, "Branch:\t%s\n", coalesce(s.GetGitBranch(), "-"))
+	fmt.Fprintf(tw, "Type:\t%s\n", coalesce(s.GetType(), "-"))
+	if len(s.GetTags()) > 0 {
+		fmt.Fprintf(tw, "Tags:\t%s\n", strings.Join(s.GetTags(), ", "))
+	}
+	fmt.Fprintf(tw, "Started:\t%s\n", formatSessionTime(s.GetStartTime()))
+	if s.GetEndTime() != "" {
+		ended := formatSessionTime(s.GetEndTime())
+		start, startErr := time.Parse(time.RFC3339, s.GetStartTime())
+		end, endErr := time.Parse(time.RFC3339, s.GetEndTime())
+		if startErr == nil && endErr == nil && !end.Before(start) {
+			ended = fmt.Sprintf("%s (%s)", ended, end.Sub(start).Round(time.Second))
+		}
+		fmt.Fprintf(tw, "Ended:\t%s\n", ended)
+	} else {
+		fmt.Fprintf(tw, "Ended:\t-\n")
+	}
+	fmt.Fprintf(tw, "Interactions:\t%d\n", s.GetInteractionCount())
+	fmt.Fprintf(tw, "Tokens:\t%s in, %s out, %s cache write, %s cache read\n",
+		formatTokenCount(s.GetInputTokens()), formatTokenCount(s.GetOutputTokens()),
+		formatTokenCount(s.GetCacheCreateTokens()), formatTokenCount(s.GetCacheReadTokens()))
+	_ = tw.Flush()
+
+	if snaps := s.GetTodoSnapshots(); len(snaps) > 0 {
+		latest := snaps[len(snaps)-1]
+		fmt.Printf("\nTodos (%d snapshot%s, latest %s):\n", len(snaps), plural(len(snaps)), formatSessionTime(latest.GetTimestamp()))
+		for _, t := range latest.GetTodos() {
+			mark := " "
+			switch t.GetStatus() {
+			case "completed":
+				mark = "x"
+			case "in_progress":
+				mark = ">"
+			}
+			fmt.Printf("  [%s] %s\n", mark, t.GetContent())
+		}
+	}
+
+	if attachments := s.GetAttachmentUrls(); len(attachments) > 0 {
+		fmt.Printf("\nAttachments (%d):\n", len(attachments))
+		for _, a := range attachments {
+			fmt.Printf("  %s  %s\n", a.GetMediaType(), coalesce(a.GetPublicUrl(), a.GetStoragePath()))
+		}
+	}
+
+	if md := s.GetMetadata(); len(md) > 0 {
+		keys := make([]string, 0, len(md))
+		for k := range md {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		fmt.Println("\nMetadata:")
+		tw = tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		for _, k := range keys {
+			fmt.Fprintf(tw, "  %s:\t%s\n", k, truncateRunes(md[k], 100))
+		}
+		_ = tw.Flush()
+	}
+}
+
+// parseTimeBound accepts RFC 3339 timestamps, YYYY-MM-DD dates (local time)
+// and relative durations such as 90m, 24h or 7d meaning that long ago. For an
+// upper bound a bare date covers the whole day.
+func parseTimeBound(value string, now time.Time, upper bool) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		if upper {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t, nil
+	}
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return now.AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("%q is not a timestamp, date or duration", value)
+}
+
+func formatSessionTime(v string) string {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return coalesce(v, "-")
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
+
+func formatTokenCount(n int64) string {
+	switch {
+	case n >= 1_000_000:
+		return fmt.Sprintf("%.1fM", float64(n)/1_000_000)
+	case n >= 1_000:
+		return fmt.Sprintf("%.1fk", float64(n)/1_000)
+	default:
+		return strconv.FormatInt(n, 10)
+	}
+}
+
+func truncateRunes(s string, n int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
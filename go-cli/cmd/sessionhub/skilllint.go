@@ -0,0 +1,369 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxSkillFiles          = 50
+	maxSkillFileBytes      = 256 * 1024
+	maxSkillBundleBytes    = 1024 * 1024
+	maxSkillNameLength     = 64
+	maxSkillDescriptionLen = 1024
+)
+
+var (
+	skillNamePattern  = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	markdownLinkRegex = regexp.MustCompile(`!?\[[^\]]*\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+	// Code blocks and spans hold examples, not links.
+	markdownCodeRegexes = []*regexp.Regexp{
+		regexp.MustCompile("(?ms)^[ \t]*```.*?(^[ \t]*```[^\n]*$|\\z)"),
+		regexp.MustCompile("(?ms)^[ \t]*~~~.*?(^[ \t]*~~~[^\n]*$|\\z)"),
+		regexp.MustCompile("``[^\n]*?``|`[^`\n]*`"),
+	}
+	linkSchemeRegex    = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:`)
+	defaultSkillIgnore = []string{".git/", ".DS_Store", "Thumbs.db", "desktop.ini", "*.swp", "*~", ".skillignore"}
+)
+
+// skillBundle is the set of files push-skill uploads for one skill, after
+// .skillignore rules have been applied. Paths are slash-separated and relative
+// to the bundle root. SingleFile is set for a bundle made from one markdown
+// file, whose title can come from the file name instead of frontmatter.
+type skillBundle struct {
+	Root       string
+	Entry      string
+	Files      map[string][]byte
+	Executable map[string]bool
+	Ignored    []string
+	SingleFile bool
+}
+
+type skillLintIssue struct {
+	Severity string `json:"severity"` // error or warning
+	File     string `json:"file,omitempty"`
+	Message  string `json:"message"`
+}
+
+type skillIgnoreRule struct {
+	pattern  string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// loadSkillDir walks a skill directory, skipping anything matched by the
+// default ignore list or the directory's .skillignore.
+func loadSkillDir(dir string) (*skillBundle, error) {
+	info, err := os.Stat(dir)
+	if err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("directory not found: %s", dir)
+	}
+	rules := parseSkillIgnore(defaultSkillIgnore)
+	if data, readErr := os.ReadFile(filepath.Join(dir, ".skillignore")); readErr == nil {
+		lines := make([]string, 0)
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		rules = append(rules, parseSkillIgnore(lines)...)
+	}
+
+	bundle := &skillBundle{Root: dir, Files: map[string][]byte{}, Executable: map[string]bool{}, Ignored: []string{}}
+	walkErr := filepath.WalkDir(dir, func(p string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		rel, relErr := filepath.Rel(dir, p)
+		if relErr != nil || rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if skillPathIgnored(rules, rel, d.IsDir()) {
+			bundle.Ignored = append(bundle.Ignored, rel)
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		b, readErr := os.ReadFile(p)
+		if readErr != nil {
+			return readErr
+		}
+		bundle.Files[rel] = b
+		if fi, infoErr := d.Info(); infoErr == nil && fi.Mode().Perm()&0o111 != 0 {
+			bundle.Executable[rel] = true
+		}
+		return nil
+	})
+	if walkErr != nil {
+		return nil, walkErr
+	}
+	if len(bundle.Files) == 0 {
+		return nil, fmt.Errorf("no files found in %s", dir)
+	}
+
+	for _, candidate := range []string{"SKILL.md", "index.md", "README.md"} {
+		if _, ok := bundle.Files[candidate]; ok {
+			bundle.Entry = candidate
+			break
+		}
+	}
+	if bundle.Entry == "" {
+		for _, rel := range bundle.sortedPaths() {
+			if strings.HasSuffix(strings.ToLower(rel), ".md") {
+				bundle.Entry = rel
+				break
+			}
+		}
+	}
+	return bundle, nil
+}
+
+// loadSkillFile wraps a single skill file as a bundle whose entry is SKILL.md.
+func loadSkillFile(file string) (*skillBundle, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not read file %s: %w", file, err)
+	}
+	return &skillBundle{Root: file, Entry: "SKILL.md", Files: map[string][]byte{"SKILL.md": b}, Executable: map[string]bool{}, Ignored: []string{}, SingleFile: true}, nil
+}
+
+func (b *skillBundle) sortedPaths() []string {
+	paths := make([]string, 0, len(b.Files))
+	for rel := range b.Files {
+		paths = append(paths, rel)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func parseSkillIgnore(lines []string) []skillIgnoreRule {
+	rules := make([]skillIgnoreRule, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rule := skillIgnoreRule{}
+		if strings.HasPrefix(line, "!") {
+			rule.negate = true
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			rule.dirOnly = true
+			line = strings.TrimSuffix(line, "/")
+		}
+		if strings.Contains(line, "/") {
+			rule.anchored = true
+			line = strings.TrimPrefix(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+		rule.pattern = line
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// skillPathIgnored applies gitignore-style rules: unanchored patterns match the
+// base name at any depth, anchored ones the full relative path, and the last
+// matching rule wins.
+func skillPathIgnored(rules []skillIgnoreRule, rel string, isDir bool) bool {
+	ignored := false
+	for _, rule := range rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		target := path.Base(rel)
+		if rule.anchored {
+			target = rel
+		}
+		if ok, _ := path.Match(rule.pattern, target); ok {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+// lintSkillBundle checks a bundle against what sync-skills and Claude Code can
+// load: entry file and frontmatter, size and count limits, text encoding and
+// relative links between the bundle's files.
+func lintSkillBundle(b *skillBundle) []skillLintIssue {
+	issues := make([]skillLintIssue, 0)
+	add := func(severity, file, format string, args ...any) {
+		issues = append(issues, skillLintIssue{Severity: severity, File: file, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(b.Files) > maxSkillFiles {
+		add("error", "", "skill has %d files; the limit is %d (use .skillignore to exclude files)", len(b.Files), maxSkillFiles)
+	}
+	total := 0
+	for _, rel := range b.sortedPaths() {
+		data := b.Files[rel]
+		total += len(data)
+		if len(data) > maxSkillFileBytes {
+			add("error", rel, "file is %s; the limit is %s", formatBytes(len(data)), formatBytes(maxSkillFileBytes))
+		}
+		if len(data) == 0 {
+			add("warning", rel, "file is empty")
+		}
+		if !isTextSkillData(data) {
+			// Binary assets are uploaded as raw bytes, but markdown has to be
+			// UTF-8 for Claude Code to read it.
+			if isMarkdownPath(rel) {
+				add("error", rel, "markdown file is not UTF-8 text")
+			}
+			continue
+		}
+		if isMarkdownPath(rel) {
+			for _, target := range brokenSkillLinks(b, rel, string(data)) {
+				add("error", rel, "broken relative link: %s", target)
+			}
+		}
+	}
+	if total > maxSkillBundleBytes {
+		add("error", "", "skill totals %s; the limit is %s", formatBytes(total), formatBytes(maxSkillBundleBytes))
+	}
+
+	if b.Entry == "" {
+		add("error", "", "no entry file: add SKILL.md (or index.md / README.md)")
+		return issues
+	}
+	if !isSkillEntryFile(b.Entry) {
+		add("warning", b.Entry, "entry file should be named SKILL.md")
+	}
+	// A single file such as CLAUDE.md is named after the file when it has
+	// no frontmatter, so missing fields only warn.
+	frontmatterSeverity := "error"
+	if b.SingleFile {
+		frontmatterSeverity = "warning"
+	}
+	entry := string(b.Files[b.Entry])
+	if !frontmatterRegex.MatchString(entry) {
+		add(frontmatterSeverity, b.Entry, "missing frontmatter: start the file with ---, name: and description:, then ---")
+		return issues
+	}
+	body, name, description := parseFrontmatter(entry)
+	switch {
+	case name == "":
+		add(frontmatterSeverity, b.Entry, "frontmatter is missing name")
+	case len(name) > maxSkillNameLength:
+		add("error", b.Entry, "frontmatter name is %d characters; the limit is %d", len(name), maxSkillNameLength)
+	case !skillNamePattern.MatchString(name):
+		add("warning", b.Entry, "frontmatter name %q should use lowercase letters, digits and hyphens", name)
+	}
+	switch {
+	case description == "":
+		add(frontmatterSeverity, b.Entry, "frontmatter is missing description")
+	case len(description) > maxSkillDescriptionLen:
+		add("error", b.Entry, "frontmatter description is %d characters; the limit is %d", len(description), maxSkillDescriptionLen)
+	}
+	if strings.TrimSpace(body) == "" {
+		add("warning", b.Entry, "skill body is empty")
+	}
+	return issues
+}
+
+// brokenSkillLinks returns relative link targets in a markdown file that do
+// not resolve to another file in the bundle.
+func brokenSkillLinks(b *skillBundle, rel, content string) []string {
+	broken := make([]string, 0)
+	for _, re := range markdownCodeRegexes {
+		content = re.ReplaceAllString(content, " ")
+	}
+	for _, m := range markdownLinkRegex.FindAllStringSubmatch(content, -1) {
+		target := m[1]
+		if strings.HasPrefix(target, "#") || strings.HasPrefix(target, "/") || linkSchemeRegex.MatchString(target) {
+			continue
+		}
+		clean := target
+		if i := strings.IndexAny(clean, "#?"); i >= 0 {
+			clean = clean[:i]
+		}
+		if clean == "" {
+			continue
+		}
+		resolved := path.Clean(path.Join(path.Dir(rel), clean))
+		if resolved == ".." || strings.HasPrefix(resolved, "../") {
+			broken = append(broken, target)
+			continue
+		}
+		if _, ok := b.Files[resolved]; ok {
+			continue
+		}
+		if b.hasDir(resolved) {
+			continue
+		}
+		broken = append(broken, target)
+	}
+	return broken
+}
+
+func isTextSkillData(data []byte) bool {
+	return utf8.Valid(data) && bytes.IndexByte(data, 0) < 0
+}
+
+func isMarkdownPath(rel string) bool {
+	ext := strings.ToLower(path.Ext(rel))
+	return ext == ".md" || ext == ".markdown"
+}
+
+// skillFileContentType guesses a MIME type from the extension, falling back to
+// content sniffing.
+func skillFileContentType(rel string, data []byte) string {
+	if isMarkdownPath(rel) {
+		return "text/markdown; charset=utf-8"
+	}
+	if ct := mime.TypeByExtension(path.Ext(rel)); ct != "" {
+		return ct
+	}
+	return http.DetectContentType(data)
+}
+
+func (b *skillBundle) hasDir(dir string) bool {
+	prefix := strings.TrimSuffix(dir, "/") + "/"
+	for rel := range b.Files {
+		if strings.HasPrefix(rel, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func countLintIssues(issues []skillLintIssue) (errs, warnings int) {
+	for _, issue := range issues {
+		if issue.Severity == "error" {
+			errs++
+		} else {
+			warnings++
+		}
+	}
+	return errs, warnings
+}
+
+func formatBytes(n int) string {
+	switch {
+	case n >= 1024*1024:
+		return fmt.Sprintf("%.1f MiB", float64(n)/(1024*1024))
+	case n >= 1024:
+		return fmt.Sprintf("%.1f KiB", float64(n)/1024)
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
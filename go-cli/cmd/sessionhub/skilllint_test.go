@@ -0,0 +1,17 @@
+package main
+
+import "testing"
+
+func TestBrokenSkillLinksIgnoresCode(t *testing.T) {
+	content := "---\nname: demo\ndescription: Demo skill\n---\n" +
+		"See [the guide](guide.md) and [missing](missing.md).\n\n" +
+		"```markdown\nLink a file like [x](path/to/file)\n```\n\n" +
+		"~~~\n[y](other/file)\n~~~\n\n" +
+		"Write `[z](inline/example)` in prose.\n"
+	b := &skillBundle{Entry: "SKILL.md", Files: map[string][]byte{"SKILL.md": []byte(content), "guide.md": []byte("# Guide\n")}}
+
+	broken := brokenSkillLinks(b, "SKILL.md", content)
+	if len(broken) != 1 || broken[0] != "missing.md" {
+		t.Fatalf("broken = %v, want only missing.md", broken)
+	}
+}
@@ -0,0 +1,890 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	pb "github.com/sessionhuborg/plugin/go-cli/proto"
+)
+
+// skillsCacheEntry records what sync-skills last wrote for a skill so local
+// edits can be told apart from stale copies. Files maps each written path
+// (relative to the skill directory) to the sha256 of its content.
+type skillsCacheEntry struct {
+	ID      string            `json:"id,omitempty"`
+	Version int32             `json:"version"`
+	Slug    string            `json:"slug"`
+	Files   map[string]string `json:"files,omitempty"`
+}
+
+type skillConflict struct {
+	Slug       string   `json:"slug"`
+	Files      []string `json:"files"`
+	Action     string   `json:"action"`
+	BackupPath string   `json:"backupPath,omitempty"`
+}
+
+func skillsCachePath() string {
+	return filepath.Join(filepath.Dir(configPath()), "skills-cache.json")
+}
+
+func skillBackupsDir() string {
+	return filepath.Join(filepath.Dir(configPath()), "skill-backups")
+}
+
+// skillsCache is the on-disk state of sync-skills. LastSyncedAt doubles as
+// the rate limit shared by --watch and the SessionStart hook.
+type skillsCache struct {
+	LastSyncedAt string                       `json:"lastSyncedAt,omitempty"`
+	Skills       map[string]*skillsCacheEntry `json:"skills"`
+}
+
+func loadSkillsCache() *skillsCache {
+	cache := &skillsCache{Skills: map[string]*skillsCacheEntry{}}
+	data, err := os.ReadFile(skillsCachePath())
+	if err != nil {
+		return cache
+	}
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return cache
+	}
+	if _, ok := probe["skills"]; ok {
+		_ = json.Unmarshal(data, cache)
+	} else {
+		// Older releases stored the slug map at the top level.
+		_ = json.Unmarshal(data, &cache.Skills)
+	}
+	if cache.Skills == nil {
+		cache.Skills = map[string]*skillsCacheEntry{}
+	}
+	for slug, entry := range cache.Skills {
+		if entry == nil {
+			delete(cache.Skills, slug)
+		}
+	}
+	return cache
+}
+
+func (c *skillsCache) lastSynced() time.Time {
+	t, err := time.Parse(time.RFC3339, c.LastSyncedAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func saveSkillsCache(cache *skillsCache) error {
+	path := skillsCachePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	payload, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := fmt.Sprintf("%s.%d.tmp", path, os.Getpid())
+	if err := os.WriteFile(tmp, payload, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+type skillSyncOptions struct {
+	TeamID         string
+	ProjectID      string
+	Scope          string
+	ConflictAction string
+	Force          bool
+	// Deadline, when set, bounds all requests together instead of giving
+	// each its own timeout.
+	Deadline time.Time
+}
+
+func (o skillSyncOptions) requestTimeout() time.Duration {
+	if o.Deadline.IsZero() {
+		return 20 * time.Second
+	}
+	return time.Until(o.Deadline)
+}
+
+// skillSyncRun holds a fetched skill list and the plan computed against the
+// local cache, ready to be reported (dry run) or applied.
+type skillSyncRun struct {
+	TeamID    string
+	SkillsDir string
+	Skills    []*pb.TeamSkillProto
+	Plan      []*skillSyncItem
+	cache     *skillsCache
+}
+
+type skillSyncResult struct {
+	TeamID    string             `json:"teamId"`
+	Skills    int                `json:"skillsSynced"`
+	New       int                `json:"new"`
+	Updated   int                `json:"updated"`
+	Unchanged int                `json:"unchanged"`
+	Removed   int                `json:"removed"`
+	Rejected  int                `json:"rejected"`
+	Conflicts []skillConflict    `json:"conflicts"`
+	Failed    []skillSyncFailure `json:"failed"`
+	SkillsDir string             `json:"skillsDir"`
+}
+
+type skillSyncFailure struct {
+	Slug  string `json:"slug"`
+	Error string `json:"error"`
+}
+
+func prepareSkillSync(client *apiClient, opts skillSyncOptions) (*skillSyncRun, error) {
+	teamID := strings.TrimSpace(opts.TeamID)
+	teamSlug := ""
+	if teamID == "" {
+		teams, err := client.ListUserTeams(opts.requestTimeout())
+		if err != nil {
+			return nil, err
+		}
+		if len(teams) == 0 {
+			return nil, errors.New("no teams found. Join or create a team first")
+		}
+		teamID = teams[0].GetId()
+		teamSlug = teams[0].GetSlug()
+	}
+
+	skills, err := client.GetTeamSkills(
+		teamID,
+		optionalString(strings.TrimSpace(opts.ProjectID)),
+		optionalString(strings.TrimSpace(opts.Scope)),
+		opts.requestTimeout(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	home, _ := os.UserHomeDir()
+	skillsDir := filepath.Join(home, ".claude", "skills")
+	cache := loadSkillsCache()
+
+	teamPrefix := teamSlug
+	if teamPrefix == "" {
+		if len(teamID) > 8 {
+			teamPrefix = teamID[:8]
+		} else {
+			teamPrefix = teamID
+		}
+	}
+
+	return &skillSyncRun{
+		TeamID:    teamID,
+		SkillsDir: skillsDir,
+		Skills:    skills,
+		Plan:      planSkillSync(skills, cache.Skills, skillsDir, teamPrefix, opts.Force),
+		cache:     cache,
+	}, nil
+}
+
+func (run *skillSyncRun) apply(conflictAction string) (*skillSyncResult, error) {
+	if err := os.MkdirAll(run.SkillsDir, 0o755); err != nil {
+		return nil, err
+	}
+	stagingRoot := skillStagingDir(run.SkillsDir)
+	cleanupSkillStaging(stagingRoot)
+
+	cache := run.cache.Skills
+	result := &skillSyncResult{TeamID: run.TeamID, Skills: len(run.Skills), Conflicts: make([]skillConflict, 0), Failed: make([]skillSyncFailure, 0), SkillsDir: run.SkillsDir}
+	fail := func(slug string, err error) {
+		result.Failed = append(result.Failed, skillSyncFailure{Slug: slug, Error: err.Error()})
+	}
+
+	for _, item := range run.Plan {
+		switch item.Action {
+		case "unchanged":
+			result.Unchanged++
+			continue
+		case "rejected":
+			result.Rejected++
+			delete(cache, item.Slug)
+			continue
+		case "remove":
+			if len(item.ModifiedFiles) > 0 {
+				conflict := skillConflict{Slug: item.Slug, Files: item.ModifiedFiles, Action: "kept"}
+				if conflictAction == "backup" {
+					if backupPath, backupErr := backupSkillFiles(item.dir, item.Slug, item.ModifiedFiles); backupErr == nil {
+						conflict.Action = "backup"
+						conflict.BackupPath = backupPath
+					}
+				}
+				result.Conflicts = append(result.Conflicts, conflict)
+				if conflict.Action == "kept" {
+					// The skill is gone on the server but has local edits:
+					// leave the directory alone and stop managing it.
+					delete(cache, item.Slug)
+					continue
+				}
+			}
+			if err := os.RemoveAll(item.dir); err != nil {
+				fail(item.Slug, err)
+				continue
+			}
+			delete(cache, item.Slug)
+			result.Removed++
+			continue
+		}
+
+		cached := cache[item.Slug]
+		keepLocal := map[string]bool{}
+		if len(item.ModifiedFiles) > 0 {
+			conflict := skillConflict{Slug: item.Slug, Files: item.ModifiedFiles, Action: conflictAction}
+			if conflictAction == "backup" {
+				backupPath, backupErr := backupSkillFiles(item.dir, item.Slug, item.ModifiedFiles)
+				if backupErr != nil {
+					conflict.Action = "skip"
+				}
+				conflict.BackupPath = backupPath
+			}
+			result.Conflicts = append(result.Conflicts, conflict)
+			if conflict.Action == "skip" {
+				continue
+			}
+			if conflict.Action == "remote" {
+				for _, f := range item.ModifiedFiles {
+					keepLocal[f] = true
+				}
+			}
+		}
+
+		hashes, err := writeSkillAtomically(item, cached, keepLocal, stagingRoot)
+		if err != nil {
+			// The previous version stays in place and in the cache, so the
+			// next sync retries this skill.
+			fail(item.Slug, err)
+			continue
+		}
+
+		if item.Action == "update" {
+			result.Updated++
+		} else {
+			result.New++
+		}
+		cache[item.Slug] = &skillsCacheEntry{ID: item.skill.GetId(), Version: item.skill.GetVersion(), Slug: item.skill.GetSlug(), Files: hashes}
+	}
+
+	run.cache.LastSyncedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := saveSkillsCache(run.cache); err != nil {
+		return result, fmt.Errorf("save skills cache: %w", err)
+	}
+	return result, nil
+}
+
+// skillStagingDir is where skills are assembled before being renamed into
+// place. It sits next to the skills directory (same filesystem, so rename is
+// atomic) but outside it, so Claude Code never loads a half-written skill.
+func skillStagingDir(skillsDir string) string {
+	return filepath.Join(filepath.Dir(skillsDir), ".sessionhub-skills-staging")
+}
+
+// cleanupSkillStaging removes staging leftovers from interrupted syncs. Recent
+// entries are left alone in case another sync is running.
+func cleanupSkillStaging(stagingRoot string) {
+	entries, err := os.ReadDir(stagingRoot)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if info, infoErr := e.Info(); infoErr == nil && time.Since(info.ModTime()) > time.Hour {
+			_ = os.RemoveAll(filepath.Join(stagingRoot, e.Name()))
+		}
+	}
+}
+
+// writeSkillAtomically builds the new version of a skill in a staging
+// directory and swaps it in with rename. Files from the previous sync that
+// the new version no longer has are dropped; files the user added are carried
+// over. It returns the content hashes to record in the cache.
+func writeSkillAtomically(item *skillSyncItem, cached *skillsCacheEntry, keepLocal map[string]bool, stagingRoot string) (map[string]string, error) {
+	if err := os.MkdirAll(stagingRoot, 0o755); err != nil {
+		return nil, err
+	}
+	stageDir, err := os.MkdirTemp(stagingRoot, item.Slug+"-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(stageDir)
+	if err := os.Chmod(stageDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	hashes := map[string]string{}
+	for relPath, f := range item.files {
+		dest := filepath.Join(stageDir, filepath.FromSlash(relPath))
+		if keepLocal[relPath] {
+			// Keep the local edit and put the server copy next to it; the
+			// old hash stays so the file is still flagged next time.
+			if err := copySkillFile(filepath.Join(item.dir, filepath.FromSlash(relPath)), dest); err != nil {
+				return nil, fmt.Errorf("keep %s: %w", relPath, err)
+			}
+			if err := writeSkillFile(dest+".remote", f.data, false); err != nil {
+				return nil, fmt.Errorf("write %s.remote: %w", relPath, err)
+			}
+			hashes[relPath] = cached.Files[relPath]
+			continue
+		}
+		if err := writeSkillFile(dest, f.data, f.executable); err != nil {
+			return nil, fmt.Errorf("write %s: %w", relPath, err)
+		}
+		hashes[relPath] = hashSkillContent(f.data)
+	}
+	// A locally edited file the new version no longer ships is kept as an
+	// untracked local file; the carry-over below skips tracked files, so it
+	// would otherwise be lost.
+	for relPath := range keepLocal {
+		if _, synced := item.files[relPath]; synced {
+			continue
+		}
+		if err := copySkillFile(filepath.Join(item.dir, filepath.FromSlash(relPath)), filepath.Join(stageDir, filepath.FromSlash(relPath))); err != nil {
+			return nil, fmt.Errorf("keep %s: %w", relPath, err)
+		}
+	}
+
+	// Entries from older releases have no file list; everything in their
+	// directories was written by sync, so nothing is carried over.
+	if cached == nil || cached.Files != nil {
+		carryErr := filepath.WalkDir(item.dir, func(p string, d fs.DirEntry, walkErr error) error {
+			if walkErr != nil {
+				if errors.Is(walkErr, fs.ErrNotExist) {
+					return nil
+				}
+				return walkErr
+			}
+			if d.IsDir() {
+				return nil
+			}
+			rel, relErr := filepath.Rel(item.dir, p)
+			if relErr != nil {
+				return relErr
+			}
+			rel = filepath.ToSlash(rel)
+			if _, synced := item.files[rel]; synced {
+				return nil
+			}
+			if cached != nil {
+				if _, tracked := cached.Files[rel]; tracked {
+					return nil
+				}
+			}
+			dest := filepath.Join(stageDir, filepath.FromSlash(rel))
+			if _, statErr := os.Lstat(dest); statErr == nil {
+				return nil
+			}
+			return copySkillFile(p, dest)
+		})
+		if carryErr != nil {
+			return nil, fmt.Errorf("carry over local files: %w", carryErr)
+		}
+	}
+
+	if err := swapSkillDir(stageDir, item.dir); err != nil {
+		return nil, err
+	}
+	return hashes, nil
+}
+
+// swapSkillDir replaces dir with stageDir. The old directory is moved aside
+// first and restored if the second rename fails.
+func swapSkillDir(stageDir, dir string) error {
+	old := ""
+	if _, err := os.Lstat(dir); err == nil {
+		old = stageDir + ".old"
+		if err := os.Rename(dir, old); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(stageDir, dir); err != nil {
+		if old != "" {
+			_ = os.Rename(old, dir)
+		}
+		return err
+	}
+	if old != "" {
+		_ = os.RemoveAll(old)
+	}
+	return nil
+}
+
+func writeSkillFile(path string, data []byte, executable bool) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	perm := os.FileMode(0o644)
+	if executable {
+		perm = 0o755
+	}
+	return os.WriteFile(path, data, perm)
+}
+
+func copySkillFile(src, dest string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(dest, data, info.Mode().Perm())
+}
+
+func (r *skillSyncResult) message() string {
+	msg := ""
+	if r.Skills == 0 {
+		msg = fmt.Sprintf("No approved team skills found; removed %d previously synced skills", r.Removed)
+	} else {
+		msg = fmt.Sprintf("Synced %d skills (%d new, %d updated, %d removed)", r.Skills, r.New, r.Updated, r.Removed)
+	}
+	if len(r.Conflicts) > 0 {
+		msg = fmt.Sprintf("%s; %d skill%s had local edits (see conflicts, or re-run with --force)", msg, len(r.Conflicts), plural(len(r.Conflicts)))
+	}
+	if len(r.Failed) > 0 {
+		msg = fmt.Sprintf("%s; %d skill%s failed and will be retried (see failed)", msg, len(r.Failed), plural(len(r.Failed)))
+	}
+	return msg
+}
+
+func (r *skillSyncResult) payload() map[string]any {
+	return map[string]any{
+		"success":      len(r.Failed) == 0,
+		"teamId":       r.TeamID,
+		"skillsSynced": r.Skills,
+		"new":          r.New,
+		"updated":      r.Updated,
+		"unchanged":    r.Unchanged,
+		"removed":      r.Removed,
+		"rejected":     r.Rejected,
+		"conflicts":    r.Conflicts,
+		"failed":       r.Failed,
+		"skillsDir":    r.SkillsDir,
+		"message":      r.message(),
+	}
+}
+
+// watchSkillSync polls for skill changes until interrupted. The interval is
+// measured from the last sync recorded in the cache, so a watcher, the
+// SessionStart hook and manual runs share one rate limit.
+func watchSkillSync(client *apiClient, opts skillSyncOptions, interval time.Duration, jsonOutput bool) int {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if !jsonOutput {
+		fmt.Fprintf(os.Stderr, "Watching team skills every %s (Ctrl-C to stop)\n", interval)
+	}
+	for {
+		if wait := interval - time.Since(loadSkillsCache().lastSynced()); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return 0
+			case <-time.After(wait):
+			}
+			continue
+		}
+
+		run, err := prepareSkillSync(client, opts)
+		var result *skillSyncResult
+		if err == nil {
+			result, err = run.apply(opts.ConflictAction)
+		}
+		now := time.Now().UTC().Format(time.RFC3339)
+		switch {
+		case err != nil && jsonOutput:
+			_ = json.NewEncoder(os.Stdout).Encode(map[string]any{"event": "error", "time": now, "success": false, "error": err.Error()})
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "[%s] Error: %v\n", now, err)
+		case jsonOutput:
+			payload := result.payload()
+			payload["event"] = "sync"
+			payload["time"] = now
+			_ = json.NewEncoder(os.Stdout).Encode(payload)
+		default:
+			fmt.Printf("[%s] %s\n", now, result.message())
+		}
+
+		// After a failure the cache timestamp is unchanged, so wait out a
+		// full interval before retrying.
+		select {
+		case <-ctx.Done():
+			return 0
+		case <-time.After(interval):
+		}
+	}
+}
+
+// skillSyncItem is one entry of the sync-skills plan. The exported fields are
+// reported to the user; the rest carry what the apply step needs.
+type skillSyncItem struct {
+	Slug          string   `json:"slug"`
+	Action        string   `json:"action"` // new, update, unchanged, remove, rejected
+	FromVersion   int32    `json:"fromVersion,omitempty"`
+	ToVersion     int32    `json:"toVersion,omitempty"`
+	ModifiedFiles []string `json:"modifiedFiles,omitempty"`
+	RejectedPaths []string `json:"rejectedPaths,omitempty"`
+	Diff          string   `json:"diff,omitempty"`
+
+	dir   string
+	skill *pb.TeamSkillProto
+	files map[string]skillFileContent
+}
+
+type skillFileContent struct {
+	data       []byte
+	executable bool
+}
+
+// planSkillSync works out what sync-skills would do without touching disk
+// beyond reading the currently synced files.
+func planSkillSync(skills []*pb.TeamSkillProto, cache map[string]*skillsCacheEntry, skillsDir, teamPrefix string, force bool) []*skillSyncItem {
+	resolvedSkillsDir, _ := filepath.Abs(skillsDir)
+	items := make([]*skillSyncItem, 0, len(skills))
+	current := map[string]bool{}
+
+	for _, skill := range skills {
+		slug := fmt.Sprintf("%s-%s", teamPrefix, skill.GetSlug())
+		item := &skillSyncItem{Slug: slug, ToVersion: skill.GetVersion(), dir: filepath.Join(skillsDir, slug), skill: skill}
+		items = append(items, item)
+		if !isWithinDir(resolvedSkillsDir, item.dir) {
+			item.Action = "rejected"
+			item.RejectedPaths = []string{slug}
+			continue
+		}
+		current[slug] = true
+
+		cached, ok := cache[slug]
+		switch {
+		case !ok:
+			item.Action = "new"
+		case cached.Version == skill.GetVersion():
+			item.Action = "unchanged"
+			item.FromVersion = cached.Version
+			continue
+		default:
+			item.Action = "update"
+			item.FromVersion = cached.Version
+		}
+
+		item.files, item.RejectedPaths = renderSkillFiles(skill, slug, item.dir)
+		if ok && !force {
+			item.ModifiedFiles = modifiedSkillFiles(item.dir, cached)
+		}
+	}
+
+	removed := make([]string, 0)
+	for slug := range cache {
+		if !current[slug] {
+			removed = append(removed, slug)
+		}
+	}
+	sort.Strings(removed)
+	for _, slug := range removed {
+		entry := cache[slug]
+		item := &skillSyncItem{Slug: slug, Action: "remove", FromVersion: entry.Version, dir: filepath.Join(skillsDir, slug)}
+		items = append(items, item)
+		if !isWithinDir(resolvedSkillsDir, item.dir) {
+			item.Action = "rejected"
+			item.RejectedPaths = []string{slug}
+			continue
+		}
+		if !force {
+			// Removing deletes the whole directory, so files the user added
+			// count as local edits too.
+			item.ModifiedFiles = append(modifiedSkillFiles(item.dir, entry), untrackedSkillFiles(item.dir, entry)...)
+			sort.Strings(item.ModifiedFiles)
+		}
+	}
+	return items
+}
+
+// skillItemDiff diffs the files currently on disk against what an update
+// would write.
+func skillItemDiff(item *skillSyncItem) string {
+	relPaths := make([]string, 0, len(item.files))
+	for relPath := range item.files {
+		relPaths = append(relPaths, relPath)
+	}
+	sort.Strings(relPaths)
+
+	var sb strings.Builder
+	for _, relPath := range relPaths {
+		var current []byte
+		if data, err := os.ReadFile(filepath.Join(item.dir, filepath.FromSlash(relPath))); err == nil {
+			current = data
+		}
+		remote := item.files[relPath].data
+		name := filepath.ToSlash(filepath.Join(item.Slug, relPath))
+		if !isTextSkillData(current) || !isTextSkillData(remote) {
+			if !bytes.Equal(current, remote) {
+				fmt.Fprintf(&sb, "Binary files %s (local) and %s (remote) differ\n", name, name)
+			}
+			continue
+		}
+		sb.WriteString(unifiedDiff(name+" (local)", name+" (remote)", string(current), string(remote), 3))
+	}
+	return sb.String()
+}
+
+// renderSkillFiles returns the files sync-skills writes for a skill, keyed by
+// path relative to the skill directory, with frontmatter prepended to the
+// entry file. Paths that would escape skillDir are returned separately.
+// bundle_files is preferred over the legacy text-only files map when the
+// backend sends it.
+func renderSkillFiles(skill *pb.TeamSkillProto, effectiveSlug, skillDir string) (map[string]skillFileContent, []string) {
+	desc := strings.ReplaceAll(skill.GetSummary(), "\n", " ")
+	if strings.TrimSpace(desc) == "" {
+		desc = strings.ReplaceAll(skill.GetTitle(), "\n", " ")
+	}
+	desc = strings.ReplaceAll(desc, "\"", "\\\"")
+	frontmatter := fmt.Sprintf("---\nname: %s\ndescription: \"%s\"\n---\n\n", effectiveSlug, desc)
+
+	files := map[string]skillFileContent{}
+	for relPath, content := range skill.GetFiles() {
+		files[relPath] = skillFileContent{data: []byte(content)}
+	}
+	if len(skill.GetBundleFiles()) > 0 {
+		files = map[string]skillFileContent{}
+		for relPath, f := range skill.GetBundleFiles() {
+			files[relPath] = skillFileContent{data: f.GetContent(), executable: f.GetExecutable()}
+		}
+	}
+
+	out := map[string]skillFileContent{}
+	rejected := make([]string, 0)
+	if len(files) <= 1 {
+		out["SKILL.md"] = skillFileContent{data: []byte(frontmatter + skill.GetContent())}
+		return out, rejected
+	}
+	resolvedDir, _ := filepath.Abs(skillDir)
+	for relPath, f := range files {
+		if strings.Contains(relPath, "..") || strings.HasPrefix(relPath, "/") || !isWithinDir(resolvedDir, filepath.Join(skillDir, relPath)) {
+			rejected = append(rejected, relPath)
+			continue
+		}
+		if isSkillEntryFile(relPath) {
+			f.data = append([]byte(frontmatter), f.data...)
+		}
+		out[relPath] = f
+	}
+	sort.Strings(rejected)
+	return out, rejected
+}
+
+func isWithinDir(resolvedBase, path string) bool {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(abs, resolvedBase+string(os.PathSeparator))
+}
+
+// findExistingTeamSkill looks for a published team skill that a push should
+// update rather than duplicate. Synced directories are named after the
+// team-prefixed slug, so candidates are first translated through the sync
+// cache, which also supplies the version the local copy was based on.
+func findExistingTeamSkill(client *apiClient, teamID, explicitSlug string, candidates []string) (*pb.TeamSkillProto, int32, error) {
+	cache := loadSkillsCache().Skills
+	if explicitSlug != "" {
+		candidates = []string{explicitSlug}
+	}
+
+	wanted := make([]string, 0, len(candidates)*2)
+	baseVersions := map[string]int32{}
+	for _, candidate := range candidates {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "" {
+			continue
+		}
+		if entry, ok := cache[candidate]; ok && entry.Slug != "" {
+			wanted = append(wanted, entry.Slug)
+			baseVersions[entry.Slug] = entry.Version
+		}
+		wanted = append(wanted, candidate)
+	}
+	if len(wanted) == 0 {
+		return nil, 0, nil
+	}
+
+	skills, err := client.GetTeamSkills(teamID, nil, nil, 20*time.Second)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, slug := range wanted {
+		for _, skill := range skills {
+			if skill.GetSlug() != slug {
+				continue
+			}
+			baseVersion, ok := baseVersions[slug]
+			if !ok {
+				baseVersion = skill.GetVersion()
+			}
+			return skill, baseVersion, nil
+		}
+	}
+	if explicitSlug != "" {
+		return nil, 0, fmt.Errorf("no published team skill with slug %q", explicitSlug)
+	}
+	return nil, 0, nil
+}
+
+func isSkillEntryFile(relPath string) bool {
+	return relPath == "SKILL.md" || relPath == "index.md" || relPath == "README.md"
+}
+
+func hashSkillContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// modifiedSkillFiles lists tracked files whose content on disk no longer
+// matches the hash recorded at the last sync. Files that were deleted locally
+// are not treated as modified.
+func modifiedSkillFiles(skillDir string, entry *skillsCacheEntry) []string {
+	if entry == nil {
+		return nil
+	}
+	modified := make([]string, 0)
+	for relPath, hash := range entry.Files {
+		data, err := os.ReadFile(filepath.Join(skillDir, filepath.FromSlash(relPath)))
+		if err != nil {
+			continue
+		}
+		if hashSkillContent(data) != hash {
+			modified = append(modified, relPath)
+		}
+	}
+	sort.Strings(modified)
+	return modified
+}
+
+// untrackedSkillFiles lists files in a synced skill directory that sync did
+// not write. Entries from older releases have no file list; everything in
+// their directories was written by sync.
+func untrackedSkillFiles(skillDir string, entry *skillsCacheEntry) []string {
+	if entry == nil || entry.Files == nil {
+		return nil
+	}
+	untracked := make([]string, 0)
+	_ = filepath.WalkDir(skillDir, func(p string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil || d.IsDir() {
+			return nil
+		}
+		rel, relErr := filepath.Rel(skillDir, p)
+		if relErr != nil {
+			return nil
+		}
+		if _, tracked := entry.Files[filepath.ToSlash(rel)]; !tracked {
+			untracked = append(untracked, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	return untracked
+}
+
+// backupSkillFiles copies the given files out of the skills directory into
+// ~/.sessionhub/skill-backups/<slug>/<timestamp>/ so Claude Code does not load
+// the backups as skills.
+func backupSkillFiles(skillDir, slug string, relPaths []string) (string, error) {
+	backupDir := filepath.Join(skillBackupsDir(), slug, time.Now().UTC().Format("20060102T150405Z"))
+	for _, relPath := range relPaths {
+		data, err := os.ReadFile(filepath.Join(skillDir, filepath.FromSlash(relPath)))
+		if err != nil {
+			return "", err
+		}
+		dest := filepath.Join(backupDir, filepath.FromSlash(relPath))
+		if err := os.MkdirAll(filepath.Dir(dest), 0o700); err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(dest, data, 0o600); err != nil {
+			return "", err
+		}
+	}
+	return backupDir, nil
+}
+
+func emitSkillSyncPlan(plan []*skillSyncItem, teamID, skillsDir, conflictAction string, jsonOutput bool) int {
+	counts := map[string]int{}
+	conflicts := make([]skillConflict, 0)
+	for _, item := range plan {
+		counts[item.Action]++
+		if item.Action == "update" {
+			item.Diff = skillItemDiff(item)
+		}
+		if len(item.ModifiedFiles) > 0 {
+			action := conflictAction
+			if item.Action == "remove" && action != "backup" {
+				action = "kept"
+			}
+			conflicts = append(conflicts, skillConflict{Slug: item.Slug, Files: item.ModifiedFiles, Action: action})
+		}
+	}
+	message := fmt.Sprintf("Dry run: would sync %d new, %d updated, %d removed (%d unchanged, %d rejected); nothing was written",
+		counts["new"], counts["update"], counts["remove"], counts["unchanged"], counts["rejected"])
+
+	if jsonOutput {
+		return emitJSONOrPretty(map[string]any{
+			"success":   true,
+			"dryRun":    true,
+			"teamId":    teamID,
+			"skillsDir": skillsDir,
+			"new":       counts["new"],
+			"updated":   counts["update"],
+			"unchanged": counts["unchanged"],
+			"removed":   counts["remove"],
+			"rejected":  counts["rejected"],
+			"conflicts": conflicts,
+			"plan":      plan,
+			"message":   message,
+		}, true)
+	}
+
+	fmt.Println(message)
+	fmt.Printf("Skills dir: %s\n", skillsDir)
+	for _, item := range plan {
+		switch item.Action {
+		case "new":
+			fmt.Printf("  + %s (new, v%d)\n", item.Slug, item.ToVersion)
+		case "update":
+			fmt.Printf("  ~ %s (v%d -> v%d)\n", item.Slug, item.FromVersion, item.ToVersion)
+		case "unchanged":
+			fmt.Printf("  = %s (unchanged, v%d)\n", item.Slug, item.ToVersion)
+		case "remove":
+			fmt.Printf("  - %s (removed on server)\n", item.Slug)
+		case "rejected":
+			fmt.Printf("  ! %s (rejected: path escapes skills directory)\n", item.Slug)
+		}
+		if item.Action != "rejected" && len(item.RejectedPaths) > 0 {
+			fmt.Printf("      rejected files: %s\n", strings.Join(item.RejectedPaths, ", "))
+		}
+		if len(item.ModifiedFiles) > 0 {
+			fmt.Printf("      local edits: %s\n", strings.Join(item.ModifiedFiles, ", "))
+		}
+	}
+	for _, item := range plan {
+		if item.Diff != "" {
+			fmt.Println()
+			fmt.Print(item.Diff)
+		}
+	}
+	return 0
+}
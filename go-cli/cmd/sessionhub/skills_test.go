@@ -0,0 +1,83 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteSkillKeepsLocalEditRemovedRemotely(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "skills", "demo")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	original := []byte("original\n")
+	if err := os.WriteFile(filepath.Join(dir, "SKILL.md"), []byte("skill v1\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "a.md"), []byte("edited locally\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cached := &skillsCacheEntry{Slug: "demo", Version: 1, Files: map[string]string{
+		"SKILL.md": hashSkillContent([]byte("skill v1\n")),
+		"a.md":     hashSkillContent(original),
+	}}
+	item := &skillSyncItem{
+		Slug:          "demo",
+		Action:        "update",
+		ModifiedFiles: []string{"a.md"},
+		dir:           dir,
+		files:         map[string]skillFileContent{"SKILL.md": {data: []byte("skill v2\n")}},
+	}
+
+	hashes, err := writeSkillAtomically(item, cached, map[string]bool{"a.md": true}, filepath.Join(root, "staging"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filepath.Join(dir, "a.md"))
+	if err != nil {
+		t.Fatalf("local edit was removed: %v", err)
+	}
+	if string(got) != "edited locally\n" {
+		t.Fatalf("a.md = %q", got)
+	}
+	if _, tracked := hashes["a.md"]; tracked {
+		t.Fatalf("a.md should no longer be tracked: %v", hashes)
+	}
+	if skill, _ := os.ReadFile(filepath.Join(dir, "SKILL.md")); string(skill) != "skill v2\n" {
+		t.Fatalf("SKILL.md = %q", skill)
+	}
+}
+
+func TestSyncRemovalKeepsUntrackedFiles(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	skillsDir := filepath.Join(t.TempDir(), "skills")
+	dir := filepath.Join(skillsDir, "team-demo")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	skill := []byte("skill v1\n")
+	if err := os.WriteFile(filepath.Join(dir, "SKILL.md"), skill, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "mine.txt"), []byte("my notes\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cache := &skillsCache{Skills: map[string]*skillsCacheEntry{
+		"team-demo": {Slug: "team-demo", Version: 1, Files: map[string]string{"SKILL.md": hashSkillContent(skill)}},
+	}}
+	run := &skillSyncRun{SkillsDir: skillsDir, cache: cache, Plan: planSkillSync(nil, cache.Skills, skillsDir, "team", false)}
+
+	result, err := run.apply("backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Conflicts) != 1 || len(result.Conflicts[0].Files) != 1 || result.Conflicts[0].Files[0] != "mine.txt" {
+		t.Fatalf("conflicts = %+v, want mine.txt reported", result.Conflicts)
+	}
+	backup, err := os.ReadFile(filepath.Join(result.Conflicts[0].BackupPath, "mine.txt"))
+	if err != nil || string(backup) != "my notes\n" {
+		t.Fatalf("mine.txt was not backed up: %q, %v", backup, err)
+	}
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/sessionhuborg/plugin/go-cli/proto"
+)
+
+// subprojectPathKey is the project metadata key that marks a project created
+// for a projectMapping.
+const subprojectPathKey = "subproject_path"
+
+// projectMapping sends sessions that work in part of a repository to their own
+// SessionHub project. Path is a slash-separated glob relative to the
+// repository root ("services/billing", "services/*", "libs/**/go"); a path
+// matches it when the path or one of its parent directories does. Repo, when
+// set, limits the mapping to one repository and is compared against the
+// normalized git remote or the absolute project path.
+type projectMapping struct {
+	Repo    string `json:"repo,omitempty"`
+	Path    string `json:"path"`
+	Project string `json:"project"`
+}
+
+// mapSubproject picks the mapping for a session, or nil when none applies.
+// Paths are taken relative to the repository root. Each touched file votes
+// for the first mapping that matches it, or for none; whichever holds a
+// majority of the touched files wins. Otherwise the session's working
+// directory decides.
+func mapSubproject(mappings []projectMapping, projectPath string, parsed *parsedSession) *projectMapping {
+	if len(mappings) == 0 || projectPath == "" {
+		return nil
+	}
+	root := coalesce(detectGitTopLevel(projectPath), projectPath)
+	remote := normalizeGitRemote(detectGitRemote(root))
+	applicable := make([]projectMapping, 0, len(mappings))
+	for _, m := range mappings {
+		if strings.TrimSpace(m.Project) == "" || strings.TrimSpace(m.Path) == "" {
+			continue
+		}
+		if m.Repo != "" && normalizeGitRemote(m.Repo) != remote && filepath.Clean(m.Repo) != filepath.Clean(root) {
+			continue
+		}
+		applicable = append(applicable, m)
+	}
+	if len(applicable) == 0 {
+		return nil
+	}
+
+	relative := func(p string) string {
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(coalesce(parsed.Cwd, projectPath), p)
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+			return ""
+		}
+		return filepath.ToSlash(rel)
+	}
+	lookup := func(rel string) *projectMapping {
+		if rel == "" {
+			return nil
+		}
+		for i := range applicable {
+			if matchMappingPath(strings.Trim(applicable[i].Path, "/"), rel) {
+				return &applicable[i]
+			}
+		}
+		return nil
+	}
+
+	// One vote per distinct file, however often it was edited.
+	files := touchedFiles(parsed)
+	votes := map[*projectMapping]int{}
+	for _, p := range files {
+		votes[lookup(relative(p))]++
+	}
+	for m, n := range votes {
+		if 2*n > len(files) {
+			// A majority outside every mapping keeps the session in the
+			// repository's own project.
+			return m
+		}
+	}
+	if parsed.Cwd != "" {
+		return lookup(relative(parsed.Cwd))
+	}
+	return nil
+}
+
+// matchMappingPath reports whether rel, or a directory containing it, matches
+// pattern. "**" matches any number of path segments; other segments use
+// path.Match syntax.
+func matchMappingPath(pattern, rel string) bool {
+	var match func(pat, segs []string) bool
+	match = func(pat, segs []string) bool {
+		if len(pat) == 0 {
+			// The pattern is used up: rel is the match or lies beneath it.
+			return true
+		}
+		if pat[0] == "**" {
+			for i := 0; i <= len(segs); i++ {
+				if match(pat[1:], segs[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segs) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pat[0], segs[0]); !ok {
+			return false
+		}
+		return match(pat[1:], segs[1:])
+	}
+	if rel == "." {
+		rel = ""
+	}
+	segs := []string{}
+	if rel != "" {
+		segs = strings.Split(rel, "/")
+	}
+	return match(strings.Split(pattern, "/"), segs)
+}
+
+// mappedProjects ensures each mapped project exists once per run, so
+// concurrent imports into the same sub-project do not race to create it.
+type mappedProjects struct {
+	client   *apiClient
+	mappings []projectMapping
+
+	mu       sync.Mutex
+	projects map[string]*pb.Project
+}
+
+func newMappedProjects(client *apiClient, mappings []projectMapping) *mappedProjects {
+	if len(mappings) == 0 {
+		return nil
+	}
+	return &mappedProjects{client: client, mappings: mappings, projects: map[string]*pb.Project{}}
+}
+
+// resolve returns the mapped project for a session, or nil when none applies.
+func (r *mappedProjects) resolve(projectPath string, parsed *parsedSession) (*pb.Project, error) {
+	if r == nil {
+		return nil, nil
+	}
+	m := mapSubproject(r.mappings, projectPath, parsed)
+	if m == nil {
+		return nil, nil
+	}
+	name := strings.TrimSpace(m.Project)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if p, ok := r.projects[name]; ok {
+		return p, nil
+	}
+	p, err := ensureSubproject(r.client, projectPath, name, m.Path)
+	if err != nil {
+		return nil, err
+	}
+	r.projects[name] = p
+	return p, nil
+}
+
+// ensureSubproject finds a mapped project by name or creates it. New
+// sub-projects share the repository's git remote and are tagged with
+// subprojectPathKey so remote matching does not mistake them for the
+// repository's own project.
+func ensureSubproject(client *apiClient, projectPath, name, pattern string) (*pb.Project, error) {
+	projects, err := client.GetProjects(20 * time.Second)
+	if err != nil {
+		return nil, err
+	}
+	if p := findProjectByName(projects, name); p != nil {
+		return p, nil
+	}
+	desc := fmt.Sprintf("Auto-created project from CLI for %s in %s", pattern, filepath.Base(projectPath))
+	return client.CreateProject(&pb.CreateProjectRequest{
+		Name:        name,
+		DisplayName: name,
+		Description: &desc,
+		GitRemote:   optionalString(detectGitRemote(projectPath)),
+		Metadata:    map[string]string{subprojectPathKey: pattern},
+	})
+}
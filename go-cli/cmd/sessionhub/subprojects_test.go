@@ -0,0 +1,26 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestMapSubprojectCountsFilesNotEdits(t *testing.T) {
+	root := t.TempDir()
+	edit := func(rel string) transcriptToolUse {
+		return transcriptToolUse{Name: "Edit", Input: map[string]any{"file_path": filepath.Join(root, rel)}}
+	}
+	parsed := &parsedSession{Cwd: root}
+	for i := 0; i < 20; i++ {
+		parsed.ToolUses = append(parsed.ToolUses, edit("packages/a/index.ts"))
+	}
+	for _, name := range []string{"one.ts", "two.ts", "three.ts", "four.ts", "five.ts"} {
+		parsed.ToolUses = append(parsed.ToolUses, edit("packages/b/"+name))
+	}
+	mappings := []projectMapping{{Path: "packages/a", Project: "a"}, {Path: "packages/b", Project: "b"}}
+
+	m := mapSubproject(mappings, root, parsed)
+	if m == nil || m.Project != "b" {
+		t.Fatalf("mapped to %+v, want project b (five files against one)", m)
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const defaultMaxThinkingChars = 8000
+
+// transcriptOptions controls optional parts of transcript parsing.
+type transcriptOptions struct {
+	// IncludeThinking keeps extended thinking blocks as "thinking"
+	// interactions, redacted and cut to MaxThinkingChars.
+	IncludeThinking  bool
+	MaxThinkingChars int
+}
+
+// transcriptOptionsFromConfig reads the capture settings:
+// "capture": {"includeThinking": true, "maxThinkingChars": 8000}.
+func transcriptOptionsFromConfig(cfg config) transcriptOptions {
+	opts := transcriptOptions{IncludeThinking: cfg.Capture.IncludeThinking, MaxThinkingChars: cfg.Capture.MaxThinkingChars}
+	if opts.MaxThinkingChars <= 0 {
+		opts.MaxThinkingChars = defaultMaxThinkingChars
+	}
+	return opts
+}
+
+var (
+	secretPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?s)-----BEGIN [A-Z ]*PRIVATE KEY-----.*?(-----END [A-Z ]*PRIVATE KEY-----|$)`),
+		regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`),
+		regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`),
+		regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{40,}\b`),
+		regexp.MustCompile(`\bsk-[A-Za-z0-9_-]{20,}`),
+		regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`),
+		regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`),
+		regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9._~+/-]{20,}=*`),
+	}
+	// Assignments such as password=..., "api_key": "...": the name is kept,
+	// the value is replaced.
+	secretAssignmentRegex = regexp.MustCompile(`(?i)\b([a-z0-9_-]*(?:api[_-]?key|secret|token|password|passwd|credential)s?["']?\s*[:=]\s*["']?)([^\s"',;]{8,})`)
+)
+
+// redactSecrets replaces credentials that commonly leak into free text with
+// [REDACTED].
+func redactSecrets(s string) string {
+	for _, re := range secretPatterns {
+		s = re.ReplaceAllString(s, "[REDACTED]")
+	}
+	return secretAssignmentRegex.ReplaceAllString(s, "${1}[REDACTED]")
+}
+
+// extractThinkingText joins the thinking blocks of an assistant message.
+// Redacted thinking blocks carry no readable text and are skipped.
+func extractThinkingText(content any) string {
+	arr, ok := content.([]any)
+	if !ok {
+		return ""
+	}
+	parts := make([]string, 0, 1)
+	for _, item := range arr {
+		m := asMap(item)
+		if strings.ToLower(asString(m["type"])) != "thinking" {
+			continue
+		}
+		if text := strings.TrimSpace(asString(m["thinking"])); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// limitThinking redacts thinking text and cuts it to maxChars runes.
+func limitThinking(text string, maxChars int) (string, bool) {
+	text = redactSecrets(text)
+	r := []rune(text)
+	if maxChars <= 0 || len(r) <= maxChars {
+		return text, false
+	}
+	return string(r[:maxChars]) + fmt.Sprintf("\n… [truncated %d characters]", len(r)-maxChars), true
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	pb "github.com/sessionhuborg/plugin/go-cli/proto"
+)
+
+// tokenUsage is a set of token counts as reported in a message's usage.
+type tokenUsage struct {
+	InputTokens       int64 `json:"inputTokens"`
+	OutputTokens      int64 `json:"outputTokens"`
+	CacheCreateTokens int64 `json:"cacheCreateTokens"`
+	CacheReadTokens   int64 `json:"cacheReadTokens"`
+}
+
+func (u *tokenUsage) add(o tokenUsage) {
+	u.InputTokens += o.InputTokens
+	u.OutputTokens += o.OutputTokens
+	u.CacheCreateTokens += o.CacheCreateTokens
+	u.CacheReadTokens += o.CacheReadTokens
+}
+
+func (u tokenUsage) isZero() bool {
+	return u == tokenUsage{}
+}
+
+func usageFromMessage(usage map[string]any) tokenUsage {
+	return tokenUsage{
+		InputTokens:       toInt64(usage["input_tokens"]),
+		OutputTokens:      toInt64(usage["output_tokens"]),
+		CacheCreateTokens: toInt64(usage["cache_creation_input_tokens"]),
+		CacheReadTokens:   toInt64(usage["cache_read_input_tokens"]),
+	}
+}
+
+// messageUsage is the usage of one API response. Index is the number of
+// interactions parsed before the response began, which places it relative
+// to the --last cut. Response is the response interaction that reports the
+// usage, if the message had text.
+type messageUsage struct {
+	Model    string
+	Index    int
+	Usage    tokenUsage
+	Response *pb.InteractionData
+}
+
+// usageLedger counts each API response once. Claude Code writes a line per
+// content block of a streamed response, all with the same message ID and
+// usage; the last line with usage replaces earlier ones, since it is the most
+// complete, and lines without usage leave it alone. Lines without a message
+// ID are counted on their own.
+type usageLedger struct {
+	byID     map[string]int
+	messages []messageUsage
+}
+
+// record adds the usage from one line of a response and returns the
+// response's position in the ledger.
+func (l *usageLedger) record(id, model string, index int, usage tokenUsage) int {
+	if i, ok := l.byID[id]; ok && id != "" {
+		if !usage.isZero() {
+			l.messages[i].Usage = usage
+		}
+		if model != "" {
+			l.messages[i].Model = model
+		}
+		return i
+	}
+	if l.byID == nil {
+		l.byID = map[string]int{}
+	}
+	if id != "" {
+		l.byID[id] = len(l.messages)
+	}
+	l.messages = append(l.messages, messageUsage{Model: model, Index: index, Usage: usage})
+	return len(l.messages) - 1
+}
+
+// attach makes it the interaction reporting message i's usage, unless an
+// earlier line of the message already has one.
+func (l *usageLedger) attach(i int, it *pb.InteractionData) {
+	if l.messages[i].Response == nil {
+		l.messages[i].Response = it
+	}
+}
+
+// applyToInteractions sets each response interaction's token counts from its
+// message's final usage, so they add up to the session totals.
+func (l *usageLedger) applyToInteractions() {
+	for _, m := range l.messages {
+		if m.Response != nil {
+			m.Response.InputTokens = int64Ptr(m.Usage.InputTokens)
+			m.Response.OutputTokens = int64Ptr(m.Usage.OutputTokens)
+		}
+	}
+}
+
+// totals sums the responses that began at or after interaction index from,
+// overall and per model. Responses without usage (such as synthetic error
+// messages) are left out of the per-model breakdown.
+func (l *usageLedger) totals(from int) (tokenUsage, map[string]tokenUsage) {
+	var total tokenUsage
+	byModel := map[string]tokenUsage{}
+	for _, m := range l.messages {
+		if m.Index < from || m.Usage.isZero() {
+			continue
+		}
+		total.add(m.Usage)
+		model := coalesce(m.Model, "unknown")
+		u := byModel[model]
+		u.add(m.Usage)
+		byModel[model] = u
+	}
+	return total, byModel
+}
+
+// addTokenMetadata records the per-model breakdown as "tokens_by_model", a
+// JSON object keyed by model name, and the models used as "models" (comma
+// separated, most output first).
+func addTokenMetadata(md map[string]string, byModel map[string]tokenUsage) {
+	if len(byModel) == 0 {
+		return
+	}
+	if b, err := json.Marshal(byModel); err == nil {
+		md["tokens_by_model"] = string(b)
+	}
+	md["models"] = strings.Join(sortedModels(byModel), ",")
+}
+
+func sortedModels(byModel map[string]tokenUsage) []string {
+	models := make([]string, 0, len(byModel))
+	for m := range byModel {
+		models = append(models, m)
+	}
+	sort.Slice(models, func(i, j int) bool {
+		a, b := byModel[models[i]], byModel[models[j]]
+		if a.OutputTokens != b.OutputTokens {
+			return a.OutputTokens > b.OutputTokens
+		}
+		return models[i] < models[j]
+	})
+	return models
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseTranscriptStreamedUsage(t *testing.T) {
+	lines := []string{
+		`{"type":"user","timestamp":"2025-01-01T00:00:00Z","sessionId":"s","message":{"role":"user","content":"Add a flag"}}`,
+		`{"type":"assistant","timestamp":"2025-01-01T00:00:01Z","message":{"id":"m1","model":"claude-sonnet-4-5","role":"assistant","content":[{"type":"text","text":"Sure."}],"usage":{"input_tokens":10,"output_tokens":2,"cache_read_input_tokens":100}}}`,
+		`{"type":"assistant","timestamp":"2025-01-01T00:00:02Z","message":{"id":"m1","model":"claude-sonnet-4-5","role":"assistant","content":[{"type":"tool_use","id":"t1","name":"Bash","input":{}}],"usage":{"input_tokens":10,"output_tokens":40,"cache_read_input_tokens":100}}}`,
+		// A trailing line without usage must not erase the message's tokens.
+		`{"type":"assistant","timestamp":"2025-01-01T00:00:03Z","message":{"id":"m1","model":"claude-sonnet-4-5","role":"assistant","content":[{"type":"tool_use","id":"t2","name":"Read","input":{}}]}}`,
+	}
+	path := filepath.Join(t.TempDir(), "s.jsonl")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := parseTranscriptFile(path, 0, transcriptOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.TotalInputTokens != 10 || parsed.TotalOutputTokens != 40 || parsed.TotalCacheReadTokens != 100 {
+		t.Fatalf("totals = %d/%d/%d, want 10/40/100", parsed.TotalInputTokens, parsed.TotalOutputTokens, parsed.TotalCacheReadTokens)
+	}
+	var outSum int64
+	for _, it := range parsed.Interactions {
+		outSum += it.GetOutputTokens()
+	}
+	if outSum != parsed.TotalOutputTokens {
+		t.Fatalf("interaction output tokens sum to %d, want %d", outSum, parsed.TotalOutputTokens)
+	}
+	if got := parsed.TokensByModel["claude-sonnet-4-5"].OutputTokens; got != 40 {
+		t.Fatalf("per-model output = %d, want 40", got)
+	}
+}
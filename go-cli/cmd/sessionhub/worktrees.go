@@ -0,0 +1,102 @@
+package main
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// gitCheckout locates a path within its git repository. Root is the working
+// tree containing the path and MainRoot the repository's main working tree;
+// they differ when the path is inside a linked worktree.
+type gitCheckout struct {
+	Root     string
+	MainRoot string
+}
+
+func (c gitCheckout) isWorktree() bool {
+	return c.Root != "" && c.MainRoot != "" && c.Root != c.MainRoot
+}
+
+// detectGitCheckout asks git where path's working tree and shared git
+// directory are. Outside a repository it returns the zero value.
+func detectGitCheckout(path string) gitCheckout {
+	output, err := exec.Command("git", "-C", path, "rev-parse", "--show-toplevel", "--git-common-dir").Output()
+	if err != nil {
+		return gitCheckout{}
+	}
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if len(lines) < 2 {
+		return gitCheckout{}
+	}
+	root := filepath.Clean(strings.TrimSpace(lines[0]))
+	commonDir := strings.TrimSpace(lines[1])
+	if !filepath.IsAbs(commonDir) {
+		commonDir = filepath.Join(path, commonDir)
+	}
+	if resolved, evalErr := filepath.EvalSymlinks(commonDir); evalErr == nil {
+		commonDir = resolved
+	}
+	// The common dir is <main>/.git, or the repository itself (such as
+	// /srv/repo.git) when it is bare and every checkout is a linked worktree.
+	mainRoot := filepath.Clean(commonDir)
+	if filepath.Base(mainRoot) == ".git" {
+		mainRoot = filepath.Dir(mainRoot)
+	}
+	return gitCheckout{Root: root, MainRoot: mainRoot}
+}
+
+// repositoryPath maps a path inside a linked worktree to the same place in
+// the main working tree, so every worktree of a repository resolves to one
+// project. Other paths are returned unchanged.
+func repositoryPath(path string) string {
+	c := detectGitCheckout(path)
+	if !c.isWorktree() {
+		return path
+	}
+	real := path
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		real = resolved
+	}
+	rel, err := filepath.Rel(c.Root, real)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return c.MainRoot
+	}
+	return filepath.Join(c.MainRoot, rel)
+}
+
+// otherCheckouts lists the other working trees of the repository whose root
+// is path, main working tree first. It returns nil when path is not the root
+// of a working tree.
+func otherCheckouts(path string) []string {
+	c := detectGitCheckout(path)
+	real := filepath.Clean(path)
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		real = resolved
+	}
+	if c.Root == "" || c.Root != real {
+		return nil
+	}
+	output, err := exec.Command("git", "-C", path, "worktree", "list", "--porcelain").Output()
+	if err != nil {
+		return nil
+	}
+	checkouts := make([]string, 0, 2)
+	for _, line := range strings.Split(string(output), "\n") {
+		if wt, ok := strings.CutPrefix(line, "worktree "); ok && filepath.Clean(wt) != c.Root {
+			checkouts = append(checkouts, filepath.Clean(wt))
+		}
+	}
+	return checkouts
+}
+
+// addWorktreeMetadata records where a session ran when that was a linked
+// worktree: "worktree_path" is the worktree and "repository_path" the main
+// working tree its project belongs to.
+func addWorktreeMetadata(md map[string]string, c gitCheckout) {
+	if !c.isWorktree() {
+		return
+	}
+	md["worktree_path"] = c.Root
+	md["repository_path"] = c.MainRoot
+}
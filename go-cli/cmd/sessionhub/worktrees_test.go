@@ -0,0 +1,35 @@
+package main
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDetectGitCheckoutBareRepository(t *testing.T) {
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	bare := filepath.Join(root, "repo.git")
+	wt := filepath.Join(root, "wt")
+	run := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-c", "user.name=t", "-c", "user.email=t@example.com"}, args...)...)
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatalf("git %v: %v", args, err)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	run("init", "-q", "--bare", bare)
+	emptyTree := run("-C", bare, "hash-object", "-t", "tree", "-w", "--stdin")
+	commit := run("-C", bare, "commit-tree", "-m", "init", emptyTree)
+	run("-C", bare, "worktree", "add", "-q", "--detach", wt, commit)
+
+	c := detectGitCheckout(wt)
+	if c.Root != wt || c.MainRoot != bare {
+		t.Fatalf("checkout = %+v, want root %s and main root %s", c, wt, bare)
+	}
+}
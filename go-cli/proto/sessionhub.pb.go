@@ -599,7 +599,9 @@ type CreateSessionRequest struct {
 	EncryptedAttachmentUrls *string `protobuf:"bytes,25,opt,name=encrypted_attachment_urls,json=encryptedAttachmentUrls,proto3,oneof" json:"encrypted_attachment_urls,omitempty"`
 	// Plan slug - path is derived as {teamId}/plans/{sessionId}/{slug}.md
 	// Plan file content is uploaded separately via UploadPlanFile RPC
-	PlanSlug      *string `protobuf:"bytes,26,opt,name=plan_slug,json=planSlug,proto3,oneof" json:"plan_slug,omitempty"`
+	PlanSlug *string `protobuf:"bytes,26,opt,name=plan_slug,json=planSlug,proto3,oneof" json:"plan_slug,omitempty"`
+	// Per-file code changes reconstructed from Edit, MultiEdit and Write tool calls
+	FileChanges   []*FileChange `protobuf:"bytes,27,rep,name=file_changes,json=fileChanges,proto3" json:"file_changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -802,6 +804,13 @@ func (x *CreateSessionRequest) GetPlanSlug() string {
 	return ""
 }
 
+func (x *CreateSessionRequest) GetFileChanges() []*FileChange {
+	if x != nil {
+		return x.FileChanges
+	}
+	return nil
+}
+
 type CreateSessionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -999,17 +1008,157 @@ func (x *GetSessionRequest) GetSessionId() string {
 	return ""
 }
 
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     *string                `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	GitBranch     *string                `protobuf:"bytes,2,opt,name=git_branch,json=gitBranch,proto3,oneof" json:"git_branch,omitempty"`
+	StartAfter    *string                `protobuf:"bytes,3,opt,name=start_after,json=startAfter,proto3,oneof" json:"start_after,omitempty"`    // RFC 3339; sessions starting at or after this time
+	StartBefore   *string                `protobuf:"bytes,4,opt,name=start_before,json=startBefore,proto3,oneof" json:"start_before,omitempty"` // RFC 3339; sessions starting before this time
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                     // Page size; server default when 0
+	PageToken     *string                `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_proto_sessionhub_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sessionhub_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSessionsRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetGitBranch() string {
+	if x != nil && x.GitBranch != nil {
+		return *x.GitBranch
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetStartAfter() string {
+	if x != nil && x.StartAfter != nil {
+		return *x.StartAfter
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetStartBefore() string {
+	if x != nil && x.StartBefore != nil {
+		return *x.StartBefore
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSessionsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"` // Newest first
+	NextPageToken *string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_proto_sessionhub_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sessionhub_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
 type UpdateSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	EndTime       *string                `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Type          *string                `protobuf:"bytes,4,opt,name=type,proto3,oneof" json:"type,omitempty"` // Session type: feature, bugfix, refactor, exploration, debugging
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	UpdateTags    bool                   `protobuf:"varint,6,opt,name=update_tags,json=updateTags,proto3" json:"update_tags,omitempty"` // Replace tags with `tags` (an empty list clears them); ignored when false
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateSessionRequest) Reset() {
 	*x = UpdateSessionRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[10]
+	mi := &file_proto_sessionhub_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1021,7 +1170,7 @@ func (x *UpdateSessionRequest) String() string {
 func (*UpdateSessionRequest) ProtoMessage() {}
 
 func (x *UpdateSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[10]
+	mi := &file_proto_sessionhub_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1034,7 +1183,7 @@ func (x *UpdateSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSessionRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSessionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{10}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateSessionRequest) GetSessionId() string {
@@ -1051,6 +1200,34 @@ func (x *UpdateSessionRequest) GetEndTime() string {
 	return ""
 }
 
+func (x *UpdateSessionRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSessionRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *UpdateSessionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateSessionRequest) GetUpdateTags() bool {
+	if x != nil {
+		return x.UpdateTags
+	}
+	return false
+}
+
 type Session struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1071,13 +1248,15 @@ type Session struct {
 	CreatedAt         string                 `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt         string                 `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	SubSessionsJson   *string                `protobuf:"bytes,19,opt,name=sub_sessions_json,json=subSessionsJson,proto3,oneof" json:"sub_sessions_json,omitempty"` // JSONB data as JSON string
+	Type              *string                `protobuf:"bytes,20,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Tags              []string               `protobuf:"bytes,21,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Session) Reset() {
 	*x = Session{}
-	mi := &file_proto_sessionhub_proto_msgTypes[11]
+	mi := &file_proto_sessionhub_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1089,7 +1268,7 @@ func (x *Session) String() string {
 func (*Session) ProtoMessage() {}
 
 func (x *Session) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[11]
+	mi := &file_proto_sessionhub_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1102,7 +1281,7 @@ func (x *Session) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Session.ProtoReflect.Descriptor instead.
 func (*Session) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{11}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Session) GetId() string {
@@ -1231,6 +1410,20 @@ func (x *Session) GetSubSessionsJson() string {
 	return ""
 }
 
+func (x *Session) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *Session) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type StreamInteractionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -1241,7 +1434,7 @@ type StreamInteractionsRequest struct {
 
 func (x *StreamInteractionsRequest) Reset() {
 	*x = StreamInteractionsRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[12]
+	mi := &file_proto_sessionhub_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1253,7 +1446,7 @@ func (x *StreamInteractionsRequest) String() string {
 func (*StreamInteractionsRequest) ProtoMessage() {}
 
 func (x *StreamInteractionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[12]
+	mi := &file_proto_sessionhub_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1266,7 +1459,7 @@ func (x *StreamInteractionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamInteractionsRequest.ProtoReflect.Descriptor instead.
 func (*StreamInteractionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{12}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StreamInteractionsRequest) GetSessionId() string {
@@ -1295,7 +1488,7 @@ type StreamInteractionsResponse struct {
 
 func (x *StreamInteractionsResponse) Reset() {
 	*x = StreamInteractionsResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[13]
+	mi := &file_proto_sessionhub_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1307,7 +1500,7 @@ func (x *StreamInteractionsResponse) String() string {
 func (*StreamInteractionsResponse) ProtoMessage() {}
 
 func (x *StreamInteractionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[13]
+	mi := &file_proto_sessionhub_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,7 +1513,7 @@ func (x *StreamInteractionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamInteractionsResponse.ProtoReflect.Descriptor instead.
 func (*StreamInteractionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{13}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *StreamInteractionsResponse) GetProcessed() int32 {
@@ -1361,7 +1554,7 @@ type AddInteractionsBatchRequest struct {
 
 func (x *AddInteractionsBatchRequest) Reset() {
 	*x = AddInteractionsBatchRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[14]
+	mi := &file_proto_sessionhub_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1373,7 +1566,7 @@ func (x *AddInteractionsBatchRequest) String() string {
 func (*AddInteractionsBatchRequest) ProtoMessage() {}
 
 func (x *AddInteractionsBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[14]
+	mi := &file_proto_sessionhub_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,7 +1579,7 @@ func (x *AddInteractionsBatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddInteractionsBatchRequest.ProtoReflect.Descriptor instead.
 func (*AddInteractionsBatchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{14}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AddInteractionsBatchRequest) GetSessionId() string {
@@ -1415,7 +1608,7 @@ type AddInteractionsBatchResponse struct {
 
 func (x *AddInteractionsBatchResponse) Reset() {
 	*x = AddInteractionsBatchResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[15]
+	mi := &file_proto_sessionhub_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1427,7 +1620,7 @@ func (x *AddInteractionsBatchResponse) String() string {
 func (*AddInteractionsBatchResponse) ProtoMessage() {}
 
 func (x *AddInteractionsBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[15]
+	mi := &file_proto_sessionhub_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1440,7 +1633,7 @@ func (x *AddInteractionsBatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddInteractionsBatchResponse.ProtoReflect.Descriptor instead.
 func (*AddInteractionsBatchResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{15}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AddInteractionsBatchResponse) GetProcessed() int32 {
@@ -1486,7 +1679,7 @@ type InteractionData struct {
 
 func (x *InteractionData) Reset() {
 	*x = InteractionData{}
-	mi := &file_proto_sessionhub_proto_msgTypes[16]
+	mi := &file_proto_sessionhub_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1498,7 +1691,7 @@ func (x *InteractionData) String() string {
 func (*InteractionData) ProtoMessage() {}
 
 func (x *InteractionData) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[16]
+	mi := &file_proto_sessionhub_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1511,7 +1704,7 @@ func (x *InteractionData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InteractionData.ProtoReflect.Descriptor instead.
 func (*InteractionData) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{16}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *InteractionData) GetTimestamp() string {
@@ -1563,6 +1756,107 @@ func (x *InteractionData) GetOutputTokens() int64 {
 	return 0
 }
 
+// FileChange summarizes how a session changed one file.
+type FileChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // Relative to the session's working directory when inside it
+	LinesAdded    int32                  `protobuf:"varint,2,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
+	LinesRemoved  int32                  `protobuf:"varint,3,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+	EditCount     int32                  `protobuf:"varint,4,opt,name=edit_count,json=editCount,proto3" json:"edit_count,omitempty"`             // Successful tool calls that changed the file
+	Created       bool                   `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`                                  // The file was created by the session
+	Diff          *string                `protobuf:"bytes,6,opt,name=diff,proto3,oneof" json:"diff,omitempty"`                                   // Unified diff, size-bounded
+	DiffTruncated bool                   `protobuf:"varint,7,opt,name=diff_truncated,json=diffTruncated,proto3" json:"diff_truncated,omitempty"` // diff was cut short or omitted to stay within bounds
+	Partial       bool                   `protobuf:"varint,8,opt,name=partial,proto3" json:"partial,omitempty"`                                  // Original content unknown: the diff covers the edited regions only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileChange) Reset() {
+	*x = FileChange{}
+	mi := &file_proto_sessionhub_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileChange) ProtoMessage() {}
+
+func (x *FileChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sessionhub_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileChange.ProtoReflect.Descriptor instead.
+func (*FileChange) Descriptor() ([]byte, []int) {
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FileChange) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileChange) GetLinesAdded() int32 {
+	if x != nil {
+		return x.LinesAdded
+	}
+	return 0
+}
+
+func (x *FileChange) GetLinesRemoved() int32 {
+	if x != nil {
+		return x.LinesRemoved
+	}
+	return 0
+}
+
+func (x *FileChange) GetEditCount() int32 {
+	if x != nil {
+		return x.EditCount
+	}
+	return 0
+}
+
+func (x *FileChange) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *FileChange) GetDiff() string {
+	if x != nil && x.Diff != nil {
+		return *x.Diff
+	}
+	return ""
+}
+
+func (x *FileChange) GetDiffTruncated() bool {
+	if x != nil {
+		return x.DiffTruncated
+	}
+	return false
+}
+
+func (x *FileChange) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
 type TodoSnapshot struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -1573,7 +1867,7 @@ type TodoSnapshot struct {
 
 func (x *TodoSnapshot) Reset() {
 	*x = TodoSnapshot{}
-	mi := &file_proto_sessionhub_proto_msgTypes[17]
+	mi := &file_proto_sessionhub_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1585,7 +1879,7 @@ func (x *TodoSnapshot) String() string {
 func (*TodoSnapshot) ProtoMessage() {}
 
 func (x *TodoSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[17]
+	mi := &file_proto_sessionhub_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1598,7 +1892,7 @@ func (x *TodoSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TodoSnapshot.ProtoReflect.Descriptor instead.
 func (*TodoSnapshot) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{17}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TodoSnapshot) GetTimestamp() string {
@@ -1626,7 +1920,7 @@ type Todo struct {
 
 func (x *Todo) Reset() {
 	*x = Todo{}
-	mi := &file_proto_sessionhub_proto_msgTypes[18]
+	mi := &file_proto_sessionhub_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1638,7 +1932,7 @@ func (x *Todo) String() string {
 func (*Todo) ProtoMessage() {}
 
 func (x *Todo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[18]
+	mi := &file_proto_sessionhub_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,7 +1945,7 @@ func (x *Todo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Todo.ProtoReflect.Descriptor instead.
 func (*Todo) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{18}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Todo) GetContent() string {
@@ -1688,7 +1982,7 @@ type AttachmentMetadata struct {
 
 func (x *AttachmentMetadata) Reset() {
 	*x = AttachmentMetadata{}
-	mi := &file_proto_sessionhub_proto_msgTypes[19]
+	mi := &file_proto_sessionhub_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1700,7 +1994,7 @@ func (x *AttachmentMetadata) String() string {
 func (*AttachmentMetadata) ProtoMessage() {}
 
 func (x *AttachmentMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[19]
+	mi := &file_proto_sessionhub_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1713,7 +2007,7 @@ func (x *AttachmentMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachmentMetadata.ProtoReflect.Descriptor instead.
 func (*AttachmentMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{19}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *AttachmentMetadata) GetStoragePath() string {
@@ -1761,7 +2055,7 @@ type GetProjectObservationsRequest struct {
 
 func (x *GetProjectObservationsRequest) Reset() {
 	*x = GetProjectObservationsRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[20]
+	mi := &file_proto_sessionhub_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1773,7 +2067,7 @@ func (x *GetProjectObservationsRequest) String() string {
 func (*GetProjectObservationsRequest) ProtoMessage() {}
 
 func (x *GetProjectObservationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[20]
+	mi := &file_proto_sessionhub_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1786,7 +2080,7 @@ func (x *GetProjectObservationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectObservationsRequest.ProtoReflect.Descriptor instead.
 func (*GetProjectObservationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{20}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetProjectObservationsRequest) GetProjectId() string {
@@ -1813,7 +2107,7 @@ type GetProjectObservationsResponse struct {
 
 func (x *GetProjectObservationsResponse) Reset() {
 	*x = GetProjectObservationsResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[21]
+	mi := &file_proto_sessionhub_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1825,7 +2119,7 @@ func (x *GetProjectObservationsResponse) String() string {
 func (*GetProjectObservationsResponse) ProtoMessage() {}
 
 func (x *GetProjectObservationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[21]
+	mi := &file_proto_sessionhub_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1838,7 +2132,7 @@ func (x *GetProjectObservationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectObservationsResponse.ProtoReflect.Descriptor instead.
 func (*GetProjectObservationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{21}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetProjectObservationsResponse) GetObservations() []*Observation {
@@ -1875,7 +2169,7 @@ type Observation struct {
 
 func (x *Observation) Reset() {
 	*x = Observation{}
-	mi := &file_proto_sessionhub_proto_msgTypes[22]
+	mi := &file_proto_sessionhub_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1887,7 +2181,7 @@ func (x *Observation) String() string {
 func (*Observation) ProtoMessage() {}
 
 func (x *Observation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[22]
+	mi := &file_proto_sessionhub_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1900,7 +2194,7 @@ func (x *Observation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Observation.ProtoReflect.Descriptor instead.
 func (*Observation) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{22}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Observation) GetId() string {
@@ -1995,7 +2289,7 @@ type GetUserPreferencesRequest struct {
 
 func (x *GetUserPreferencesRequest) Reset() {
 	*x = GetUserPreferencesRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[23]
+	mi := &file_proto_sessionhub_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2007,7 +2301,7 @@ func (x *GetUserPreferencesRequest) String() string {
 func (*GetUserPreferencesRequest) ProtoMessage() {}
 
 func (x *GetUserPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[23]
+	mi := &file_proto_sessionhub_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2020,7 +2314,7 @@ func (x *GetUserPreferencesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPreferencesRequest.ProtoReflect.Descriptor instead.
 func (*GetUserPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{23}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{26}
 }
 
 type GetUserPreferencesResponse struct {
@@ -2038,7 +2332,7 @@ type GetUserPreferencesResponse struct {
 
 func (x *GetUserPreferencesResponse) Reset() {
 	*x = GetUserPreferencesResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[24]
+	mi := &file_proto_sessionhub_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2050,7 +2344,7 @@ func (x *GetUserPreferencesResponse) String() string {
 func (*GetUserPreferencesResponse) ProtoMessage() {}
 
 func (x *GetUserPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[24]
+	mi := &file_proto_sessionhub_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2063,7 +2357,7 @@ func (x *GetUserPreferencesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPreferencesResponse.ProtoReflect.Descriptor instead.
 func (*GetUserPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{24}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetUserPreferencesResponse) GetAutoAnalysis() bool {
@@ -2128,7 +2422,7 @@ type UploadAttachmentRequest struct {
 
 func (x *UploadAttachmentRequest) Reset() {
 	*x = UploadAttachmentRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[25]
+	mi := &file_proto_sessionhub_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2140,7 +2434,7 @@ func (x *UploadAttachmentRequest) String() string {
 func (*UploadAttachmentRequest) ProtoMessage() {}
 
 func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[25]
+	mi := &file_proto_sessionhub_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2153,7 +2447,7 @@ func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
 func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{25}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UploadAttachmentRequest) GetSessionId() string {
@@ -2203,7 +2497,7 @@ type UploadAttachmentResponse struct {
 
 func (x *UploadAttachmentResponse) Reset() {
 	*x = UploadAttachmentResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[26]
+	mi := &file_proto_sessionhub_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2215,7 +2509,7 @@ func (x *UploadAttachmentResponse) String() string {
 func (*UploadAttachmentResponse) ProtoMessage() {}
 
 func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[26]
+	mi := &file_proto_sessionhub_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2228,7 +2522,7 @@ func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
 func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{26}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UploadAttachmentResponse) GetSuccess() bool {
@@ -2270,7 +2564,7 @@ type UploadPlanFileRequest struct {
 
 func (x *UploadPlanFileRequest) Reset() {
 	*x = UploadPlanFileRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[27]
+	mi := &file_proto_sessionhub_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2282,7 +2576,7 @@ func (x *UploadPlanFileRequest) String() string {
 func (*UploadPlanFileRequest) ProtoMessage() {}
 
 func (x *UploadPlanFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[27]
+	mi := &file_proto_sessionhub_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2295,7 +2589,7 @@ func (x *UploadPlanFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadPlanFileRequest.ProtoReflect.Descriptor instead.
 func (*UploadPlanFileRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{27}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UploadPlanFileRequest) GetSessionId() string {
@@ -2329,7 +2623,7 @@ type UploadPlanFileResponse struct {
 
 func (x *UploadPlanFileResponse) Reset() {
 	*x = UploadPlanFileResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[28]
+	mi := &file_proto_sessionhub_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2341,7 +2635,7 @@ func (x *UploadPlanFileResponse) String() string {
 func (*UploadPlanFileResponse) ProtoMessage() {}
 
 func (x *UploadPlanFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[28]
+	mi := &file_proto_sessionhub_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2354,7 +2648,7 @@ func (x *UploadPlanFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadPlanFileResponse.ProtoReflect.Descriptor instead.
 func (*UploadPlanFileResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{28}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UploadPlanFileResponse) GetSuccess() bool {
@@ -2379,7 +2673,7 @@ type GetSessionQuotaRequest struct {
 
 func (x *GetSessionQuotaRequest) Reset() {
 	*x = GetSessionQuotaRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[29]
+	mi := &file_proto_sessionhub_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2391,7 +2685,7 @@ func (x *GetSessionQuotaRequest) String() string {
 func (*GetSessionQuotaRequest) ProtoMessage() {}
 
 func (x *GetSessionQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[29]
+	mi := &file_proto_sessionhub_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2404,7 +2698,7 @@ func (x *GetSessionQuotaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSessionQuotaRequest.ProtoReflect.Descriptor instead.
 func (*GetSessionQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{29}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{32}
 }
 
 type GetSessionQuotaResponse struct {
@@ -2419,7 +2713,7 @@ type GetSessionQuotaResponse struct {
 
 func (x *GetSessionQuotaResponse) Reset() {
 	*x = GetSessionQuotaResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[30]
+	mi := &file_proto_sessionhub_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2431,7 +2725,7 @@ func (x *GetSessionQuotaResponse) String() string {
 func (*GetSessionQuotaResponse) ProtoMessage() {}
 
 func (x *GetSessionQuotaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[30]
+	mi := &file_proto_sessionhub_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2444,7 +2738,7 @@ func (x *GetSessionQuotaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSessionQuotaResponse.ProtoReflect.Descriptor instead.
 func (*GetSessionQuotaResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{30}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetSessionQuotaResponse) GetCurrentCount() int32 {
@@ -2499,7 +2793,7 @@ type Team struct {
 
 func (x *Team) Reset() {
 	*x = Team{}
-	mi := &file_proto_sessionhub_proto_msgTypes[31]
+	mi := &file_proto_sessionhub_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2511,7 +2805,7 @@ func (x *Team) String() string {
 func (*Team) ProtoMessage() {}
 
 func (x *Team) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[31]
+	mi := &file_proto_sessionhub_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2524,7 +2818,7 @@ func (x *Team) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Team.ProtoReflect.Descriptor instead.
 func (*Team) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{31}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *Team) GetId() string {
@@ -2642,7 +2936,7 @@ type TeamMember struct {
 
 func (x *TeamMember) Reset() {
 	*x = TeamMember{}
-	mi := &file_proto_sessionhub_proto_msgTypes[32]
+	mi := &file_proto_sessionhub_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2654,7 +2948,7 @@ func (x *TeamMember) String() string {
 func (*TeamMember) ProtoMessage() {}
 
 func (x *TeamMember) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[32]
+	mi := &file_proto_sessionhub_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2667,7 +2961,7 @@ func (x *TeamMember) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
 func (*TeamMember) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{32}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *TeamMember) GetId() string {
@@ -2743,7 +3037,7 @@ type TeamInvitation struct {
 
 func (x *TeamInvitation) Reset() {
 	*x = TeamInvitation{}
-	mi := &file_proto_sessionhub_proto_msgTypes[33]
+	mi := &file_proto_sessionhub_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2755,7 +3049,7 @@ func (x *TeamInvitation) String() string {
 func (*TeamInvitation) ProtoMessage() {}
 
 func (x *TeamInvitation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[33]
+	mi := &file_proto_sessionhub_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2768,7 +3062,7 @@ func (x *TeamInvitation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamInvitation.ProtoReflect.Descriptor instead.
 func (*TeamInvitation) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{33}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *TeamInvitation) GetId() string {
@@ -2844,7 +3138,7 @@ type TeamSubscription struct {
 
 func (x *TeamSubscription) Reset() {
 	*x = TeamSubscription{}
-	mi := &file_proto_sessionhub_proto_msgTypes[34]
+	mi := &file_proto_sessionhub_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2856,7 +3150,7 @@ func (x *TeamSubscription) String() string {
 func (*TeamSubscription) ProtoMessage() {}
 
 func (x *TeamSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[34]
+	mi := &file_proto_sessionhub_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2869,7 +3163,7 @@ func (x *TeamSubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamSubscription.ProtoReflect.Descriptor instead.
 func (*TeamSubscription) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{34}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *TeamSubscription) GetId() string {
@@ -2943,7 +3237,7 @@ type CreateTeamRequest struct {
 
 func (x *CreateTeamRequest) Reset() {
 	*x = CreateTeamRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[35]
+	mi := &file_proto_sessionhub_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2955,7 +3249,7 @@ func (x *CreateTeamRequest) String() string {
 func (*CreateTeamRequest) ProtoMessage() {}
 
 func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[35]
+	mi := &file_proto_sessionhub_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2968,7 +3262,7 @@ func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
 func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{35}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CreateTeamRequest) GetName() string {
@@ -3026,7 +3320,7 @@ type GetTeamRequest struct {
 
 func (x *GetTeamRequest) Reset() {
 	*x = GetTeamRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[36]
+	mi := &file_proto_sessionhub_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3038,7 +3332,7 @@ func (x *GetTeamRequest) String() string {
 func (*GetTeamRequest) ProtoMessage() {}
 
 func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[36]
+	mi := &file_proto_sessionhub_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3051,7 +3345,7 @@ func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
 func (*GetTeamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{36}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetTeamRequest) GetIdentifier() isGetTeamRequest_Identifier {
@@ -3108,7 +3402,7 @@ type UpdateTeamRequest struct {
 
 func (x *UpdateTeamRequest) Reset() {
 	*x = UpdateTeamRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[37]
+	mi := &file_proto_sessionhub_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3120,7 +3414,7 @@ func (x *UpdateTeamRequest) String() string {
 func (*UpdateTeamRequest) ProtoMessage() {}
 
 func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[37]
+	mi := &file_proto_sessionhub_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3133,7 +3427,7 @@ func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTeamRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{37}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *UpdateTeamRequest) GetTeamId() string {
@@ -3180,7 +3474,7 @@ type DeleteTeamRequest struct {
 
 func (x *DeleteTeamRequest) Reset() {
 	*x = DeleteTeamRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[38]
+	mi := &file_proto_sessionhub_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3192,7 +3486,7 @@ func (x *DeleteTeamRequest) String() string {
 func (*DeleteTeamRequest) ProtoMessage() {}
 
 func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[38]
+	mi := &file_proto_sessionhub_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3205,7 +3499,7 @@ func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{38}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *DeleteTeamRequest) GetTeamId() string {
@@ -3225,7 +3519,7 @@ type DeleteTeamResponse struct {
 
 func (x *DeleteTeamResponse) Reset() {
 	*x = DeleteTeamResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[39]
+	mi := &file_proto_sessionhub_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3237,7 +3531,7 @@ func (x *DeleteTeamResponse) String() string {
 func (*DeleteTeamResponse) ProtoMessage() {}
 
 func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[39]
+	mi := &file_proto_sessionhub_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3250,7 +3544,7 @@ func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{39}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *DeleteTeamResponse) GetSuccess() bool {
@@ -3275,7 +3569,7 @@ type ListUserTeamsRequest struct {
 
 func (x *ListUserTeamsRequest) Reset() {
 	*x = ListUserTeamsRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[40]
+	mi := &file_proto_sessionhub_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3287,7 +3581,7 @@ func (x *ListUserTeamsRequest) String() string {
 func (*ListUserTeamsRequest) ProtoMessage() {}
 
 func (x *ListUserTeamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[40]
+	mi := &file_proto_sessionhub_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3300,7 +3594,7 @@ func (x *ListUserTeamsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserTeamsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserTeamsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{40}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{43}
 }
 
 type ListUserTeamsResponse struct {
@@ -3312,7 +3606,7 @@ type ListUserTeamsResponse struct {
 
 func (x *ListUserTeamsResponse) Reset() {
 	*x = ListUserTeamsResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[41]
+	mi := &file_proto_sessionhub_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3324,7 +3618,7 @@ func (x *ListUserTeamsResponse) String() string {
 func (*ListUserTeamsResponse) ProtoMessage() {}
 
 func (x *ListUserTeamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[41]
+	mi := &file_proto_sessionhub_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3337,7 +3631,7 @@ func (x *ListUserTeamsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserTeamsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserTeamsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{41}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ListUserTeamsResponse) GetTeams() []*Team {
@@ -3358,7 +3652,7 @@ type InviteMemberRequest struct {
 
 func (x *InviteMemberRequest) Reset() {
 	*x = InviteMemberRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[42]
+	mi := &file_proto_sessionhub_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3370,7 +3664,7 @@ func (x *InviteMemberRequest) String() string {
 func (*InviteMemberRequest) ProtoMessage() {}
 
 func (x *InviteMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[42]
+	mi := &file_proto_sessionhub_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3383,7 +3677,7 @@ func (x *InviteMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InviteMemberRequest.ProtoReflect.Descriptor instead.
 func (*InviteMemberRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{42}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *InviteMemberRequest) GetTeamId() string {
@@ -3420,7 +3714,7 @@ type InviteMemberResponse struct {
 
 func (x *InviteMemberResponse) Reset() {
 	*x = InviteMemberResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[43]
+	mi := &file_proto_sessionhub_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3432,7 +3726,7 @@ func (x *InviteMemberResponse) String() string {
 func (*InviteMemberResponse) ProtoMessage() {}
 
 func (x *InviteMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[43]
+	mi := &file_proto_sessionhub_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3445,7 +3739,7 @@ func (x *InviteMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InviteMemberResponse.ProtoReflect.Descriptor instead.
 func (*InviteMemberResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{43}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *InviteMemberResponse) GetSuccess() bool {
@@ -3494,7 +3788,7 @@ type AcceptInvitationRequest struct {
 
 func (x *AcceptInvitationRequest) Reset() {
 	*x = AcceptInvitationRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[44]
+	mi := &file_proto_sessionhub_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3506,7 +3800,7 @@ func (x *AcceptInvitationRequest) String() string {
 func (*AcceptInvitationRequest) ProtoMessage() {}
 
 func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[44]
+	mi := &file_proto_sessionhub_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3519,7 +3813,7 @@ func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
 func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{44}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *AcceptInvitationRequest) GetToken() string {
@@ -3549,7 +3843,7 @@ type AcceptInvitationResponse struct {
 
 func (x *AcceptInvitationResponse) Reset() {
 	*x = AcceptInvitationResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[45]
+	mi := &file_proto_sessionhub_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3561,7 +3855,7 @@ func (x *AcceptInvitationResponse) String() string {
 func (*AcceptInvitationResponse) ProtoMessage() {}
 
 func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[45]
+	mi := &file_proto_sessionhub_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3574,7 +3868,7 @@ func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptInvitationResponse.ProtoReflect.Descriptor instead.
 func (*AcceptInvitationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{45}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *AcceptInvitationResponse) GetSuccess() bool {
@@ -3621,7 +3915,7 @@ type RevokeInvitationRequest struct {
 
 func (x *RevokeInvitationRequest) Reset() {
 	*x = RevokeInvitationRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[46]
+	mi := &file_proto_sessionhub_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3633,7 +3927,7 @@ func (x *RevokeInvitationRequest) String() string {
 func (*RevokeInvitationRequest) ProtoMessage() {}
 
 func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[46]
+	mi := &file_proto_sessionhub_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3646,7 +3940,7 @@ func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
 func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{46}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *RevokeInvitationRequest) GetInvitationId() string {
@@ -3666,7 +3960,7 @@ type RevokeInvitationResponse struct {
 
 func (x *RevokeInvitationResponse) Reset() {
 	*x = RevokeInvitationResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[47]
+	mi := &file_proto_sessionhub_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3678,7 +3972,7 @@ func (x *RevokeInvitationResponse) String() string {
 func (*RevokeInvitationResponse) ProtoMessage() {}
 
 func (x *RevokeInvitationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[47]
+	mi := &file_proto_sessionhub_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3691,7 +3985,7 @@ func (x *RevokeInvitationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeInvitationResponse.ProtoReflect.Descriptor instead.
 func (*RevokeInvitationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{47}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *RevokeInvitationResponse) GetSuccess() bool {
@@ -3717,7 +4011,7 @@ type ListPendingInvitationsRequest struct {
 
 func (x *ListPendingInvitationsRequest) Reset() {
 	*x = ListPendingInvitationsRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[48]
+	mi := &file_proto_sessionhub_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3729,7 +4023,7 @@ func (x *ListPendingInvitationsRequest) String() string {
 func (*ListPendingInvitationsRequest) ProtoMessage() {}
 
 func (x *ListPendingInvitationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[48]
+	mi := &file_proto_sessionhub_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3742,7 +4036,7 @@ func (x *ListPendingInvitationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPendingInvitationsRequest.ProtoReflect.Descriptor instead.
 func (*ListPendingInvitationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{48}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ListPendingInvitationsRequest) GetTeamId() string {
@@ -3761,7 +4055,7 @@ type ListPendingInvitationsResponse struct {
 
 func (x *ListPendingInvitationsResponse) Reset() {
 	*x = ListPendingInvitationsResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[49]
+	mi := &file_proto_sessionhub_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3773,7 +4067,7 @@ func (x *ListPendingInvitationsResponse) String() string {
 func (*ListPendingInvitationsResponse) ProtoMessage() {}
 
 func (x *ListPendingInvitationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[49]
+	mi := &file_proto_sessionhub_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3786,7 +4080,7 @@ func (x *ListPendingInvitationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPendingInvitationsResponse.ProtoReflect.Descriptor instead.
 func (*ListPendingInvitationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{49}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ListPendingInvitationsResponse) GetInvitations() []*TeamInvitation {
@@ -3806,7 +4100,7 @@ type RemoveMemberRequest struct {
 
 func (x *RemoveMemberRequest) Reset() {
 	*x = RemoveMemberRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[50]
+	mi := &file_proto_sessionhub_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3818,7 +4112,7 @@ func (x *RemoveMemberRequest) String() string {
 func (*RemoveMemberRequest) ProtoMessage() {}
 
 func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[50]
+	mi := &file_proto_sessionhub_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3831,7 +4125,7 @@ func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
 func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{50}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *RemoveMemberRequest) GetTeamId() string {
@@ -3858,7 +4152,7 @@ type RemoveMemberResponse struct {
 
 func (x *RemoveMemberResponse) Reset() {
 	*x = RemoveMemberResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[51]
+	mi := &file_proto_sessionhub_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3870,7 +4164,7 @@ func (x *RemoveMemberResponse) String() string {
 func (*RemoveMemberResponse) ProtoMessage() {}
 
 func (x *RemoveMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[51]
+	mi := &file_proto_sessionhub_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3883,7 +4177,7 @@ func (x *RemoveMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveMemberResponse.ProtoReflect.Descriptor instead.
 func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{51}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *RemoveMemberResponse) GetSuccess() bool {
@@ -3911,7 +4205,7 @@ type UpdateMemberRoleRequest struct {
 
 func (x *UpdateMemberRoleRequest) Reset() {
 	*x = UpdateMemberRoleRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[52]
+	mi := &file_proto_sessionhub_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3923,7 +4217,7 @@ func (x *UpdateMemberRoleRequest) String() string {
 func (*UpdateMemberRoleRequest) ProtoMessage() {}
 
 func (x *UpdateMemberRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[52]
+	mi := &file_proto_sessionhub_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3936,7 +4230,7 @@ func (x *UpdateMemberRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMemberRoleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMemberRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{52}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *UpdateMemberRoleRequest) GetTeamId() string {
@@ -3971,7 +4265,7 @@ type UpdateMemberRoleResponse struct {
 
 func (x *UpdateMemberRoleResponse) Reset() {
 	*x = UpdateMemberRoleResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[53]
+	mi := &file_proto_sessionhub_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3983,7 +4277,7 @@ func (x *UpdateMemberRoleResponse) String() string {
 func (*UpdateMemberRoleResponse) ProtoMessage() {}
 
 func (x *UpdateMemberRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[53]
+	mi := &file_proto_sessionhub_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3996,7 +4290,7 @@ func (x *UpdateMemberRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMemberRoleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateMemberRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{53}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *UpdateMemberRoleResponse) GetSuccess() bool {
@@ -4029,7 +4323,7 @@ type ListMembersRequest struct {
 
 func (x *ListMembersRequest) Reset() {
 	*x = ListMembersRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[54]
+	mi := &file_proto_sessionhub_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4041,7 +4335,7 @@ func (x *ListMembersRequest) String() string {
 func (*ListMembersRequest) ProtoMessage() {}
 
 func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[54]
+	mi := &file_proto_sessionhub_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4054,7 +4348,7 @@ func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
 func (*ListMembersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{54}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ListMembersRequest) GetTeamId() string {
@@ -4074,7 +4368,7 @@ type ListMembersResponse struct {
 
 func (x *ListMembersResponse) Reset() {
 	*x = ListMembersResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[55]
+	mi := &file_proto_sessionhub_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4086,7 +4380,7 @@ func (x *ListMembersResponse) String() string {
 func (*ListMembersResponse) ProtoMessage() {}
 
 func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[55]
+	mi := &file_proto_sessionhub_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4099,7 +4393,7 @@ func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
 func (*ListMembersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{55}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ListMembersResponse) GetMembers() []*TeamMember {
@@ -4126,7 +4420,7 @@ type TransferOwnershipRequest struct {
 
 func (x *TransferOwnershipRequest) Reset() {
 	*x = TransferOwnershipRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[56]
+	mi := &file_proto_sessionhub_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4138,7 +4432,7 @@ func (x *TransferOwnershipRequest) String() string {
 func (*TransferOwnershipRequest) ProtoMessage() {}
 
 func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[56]
+	mi := &file_proto_sessionhub_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4151,7 +4445,7 @@ func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
 func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{56}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *TransferOwnershipRequest) GetTeamId() string {
@@ -4178,7 +4472,7 @@ type TransferOwnershipResponse struct {
 
 func (x *TransferOwnershipResponse) Reset() {
 	*x = TransferOwnershipResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[57]
+	mi := &file_proto_sessionhub_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4190,7 +4484,7 @@ func (x *TransferOwnershipResponse) String() string {
 func (*TransferOwnershipResponse) ProtoMessage() {}
 
 func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[57]
+	mi := &file_proto_sessionhub_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4203,7 +4497,7 @@ func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferOwnershipResponse.ProtoReflect.Descriptor instead.
 func (*TransferOwnershipResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{57}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *TransferOwnershipResponse) GetSuccess() bool {
@@ -4229,7 +4523,7 @@ type GetTeamPublicKeyRequest struct {
 
 func (x *GetTeamPublicKeyRequest) Reset() {
 	*x = GetTeamPublicKeyRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[58]
+	mi := &file_proto_sessionhub_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4241,7 +4535,7 @@ func (x *GetTeamPublicKeyRequest) String() string {
 func (*GetTeamPublicKeyRequest) ProtoMessage() {}
 
 func (x *GetTeamPublicKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[58]
+	mi := &file_proto_sessionhub_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4254,7 +4548,7 @@ func (x *GetTeamPublicKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeamPublicKeyRequest.ProtoReflect.Descriptor instead.
 func (*GetTeamPublicKeyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{58}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetTeamPublicKeyRequest) GetTeamId() string {
@@ -4274,7 +4568,7 @@ type GetTeamPublicKeyResponse struct {
 
 func (x *GetTeamPublicKeyResponse) Reset() {
 	*x = GetTeamPublicKeyResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[59]
+	mi := &file_proto_sessionhub_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4286,7 +4580,7 @@ func (x *GetTeamPublicKeyResponse) String() string {
 func (*GetTeamPublicKeyResponse) ProtoMessage() {}
 
 func (x *GetTeamPublicKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[59]
+	mi := &file_proto_sessionhub_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4299,7 +4593,7 @@ func (x *GetTeamPublicKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeamPublicKeyResponse.ProtoReflect.Descriptor instead.
 func (*GetTeamPublicKeyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{59}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GetTeamPublicKeyResponse) GetPublicKey() string {
@@ -4324,7 +4618,7 @@ type GetUserPublicKeyRequest struct {
 
 func (x *GetUserPublicKeyRequest) Reset() {
 	*x = GetUserPublicKeyRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[60]
+	mi := &file_proto_sessionhub_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4336,7 +4630,7 @@ func (x *GetUserPublicKeyRequest) String() string {
 func (*GetUserPublicKeyRequest) ProtoMessage() {}
 
 func (x *GetUserPublicKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[60]
+	mi := &file_proto_sessionhub_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4349,7 +4643,7 @@ func (x *GetUserPublicKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPublicKeyRequest.ProtoReflect.Descriptor instead.
 func (*GetUserPublicKeyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{60}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{63}
 }
 
 type GetUserPublicKeyResponse struct {
@@ -4362,7 +4656,7 @@ type GetUserPublicKeyResponse struct {
 
 func (x *GetUserPublicKeyResponse) Reset() {
 	*x = GetUserPublicKeyResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[61]
+	mi := &file_proto_sessionhub_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4374,7 +4668,7 @@ func (x *GetUserPublicKeyResponse) String() string {
 func (*GetUserPublicKeyResponse) ProtoMessage() {}
 
 func (x *GetUserPublicKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[61]
+	mi := &file_proto_sessionhub_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4387,7 +4681,7 @@ func (x *GetUserPublicKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPublicKeyResponse.ProtoReflect.Descriptor instead.
 func (*GetUserPublicKeyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{61}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetUserPublicKeyResponse) GetPublicKey() string {
@@ -4415,7 +4709,7 @@ type GetTeamSkillsRequest struct {
 
 func (x *GetTeamSkillsRequest) Reset() {
 	*x = GetTeamSkillsRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[62]
+	mi := &file_proto_sessionhub_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4427,7 +4721,7 @@ func (x *GetTeamSkillsRequest) String() string {
 func (*GetTeamSkillsRequest) ProtoMessage() {}
 
 func (x *GetTeamSkillsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[62]
+	mi := &file_proto_sessionhub_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4440,7 +4734,7 @@ func (x *GetTeamSkillsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeamSkillsRequest.ProtoReflect.Descriptor instead.
 func (*GetTeamSkillsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{62}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *GetTeamSkillsRequest) GetTeamId() string {
@@ -4477,14 +4771,15 @@ type TeamSkillProto struct {
 	Version         int32                  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
 	LastPublishedAt *string                `protobuf:"bytes,10,opt,name=last_published_at,json=lastPublishedAt,proto3,oneof" json:"last_published_at,omitempty"`
 	ProjectId       *string                `protobuf:"bytes,11,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
-	Files           map[string]string      `protobuf:"bytes,12,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Files           map[string]string      `protobuf:"bytes,12,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`                                // UTF-8 text files only (legacy)
+	BundleFiles     map[string]*SkillFile  `protobuf:"bytes,13,rep,name=bundle_files,json=bundleFiles,proto3" json:"bundle_files,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // All files as raw bytes; preferred over files when set
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *TeamSkillProto) Reset() {
 	*x = TeamSkillProto{}
-	mi := &file_proto_sessionhub_proto_msgTypes[63]
+	mi := &file_proto_sessionhub_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4496,7 +4791,7 @@ func (x *TeamSkillProto) String() string {
 func (*TeamSkillProto) ProtoMessage() {}
 
 func (x *TeamSkillProto) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[63]
+	mi := &file_proto_sessionhub_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4509,7 +4804,7 @@ func (x *TeamSkillProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamSkillProto.ProtoReflect.Descriptor instead.
 func (*TeamSkillProto) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{63}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *TeamSkillProto) GetId() string {
@@ -4596,6 +4891,75 @@ func (x *TeamSkillProto) GetFiles() map[string]string {
 	return nil
 }
 
+func (x *TeamSkillProto) GetBundleFiles() map[string]*SkillFile {
+	if x != nil {
+		return x.BundleFiles
+	}
+	return nil
+}
+
+// A file in a multi-file skill bundle, stored as raw bytes so binary assets
+// and non-UTF-8 text survive the round trip.
+type SkillFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // MIME type, e.g. "text/markdown", "image/png"
+	Executable    bool                   `protobuf:"varint,3,opt,name=executable,proto3" json:"executable,omitempty"`                     // Restore the executable bit when syncing (scripts)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkillFile) Reset() {
+	*x = SkillFile{}
+	mi := &file_proto_sessionhub_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkillFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkillFile) ProtoMessage() {}
+
+func (x *SkillFile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sessionhub_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkillFile.ProtoReflect.Descriptor instead.
+func (*SkillFile) Descriptor() ([]byte, []int) {
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SkillFile) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *SkillFile) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *SkillFile) GetExecutable() bool {
+	if x != nil {
+		return x.Executable
+	}
+	return false
+}
+
 type GetTeamSkillsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Skills        []*TeamSkillProto      `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
@@ -4605,7 +4969,7 @@ type GetTeamSkillsResponse struct {
 
 func (x *GetTeamSkillsResponse) Reset() {
 	*x = GetTeamSkillsResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[64]
+	mi := &file_proto_sessionhub_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4617,7 +4981,7 @@ func (x *GetTeamSkillsResponse) String() string {
 func (*GetTeamSkillsResponse) ProtoMessage() {}
 
 func (x *GetTeamSkillsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[64]
+	mi := &file_proto_sessionhub_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4630,7 +4994,7 @@ func (x *GetTeamSkillsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeamSkillsResponse.ProtoReflect.Descriptor instead.
 func (*GetTeamSkillsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{64}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *GetTeamSkillsResponse) GetSkills() []*TeamSkillProto {
@@ -4649,15 +5013,16 @@ type CreateTeamSkillRequest struct {
 	Summary       *string                `protobuf:"bytes,5,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
 	Category      *string                `protobuf:"bytes,6,opt,name=category,proto3,oneof" json:"category,omitempty"`
 	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
-	Scope         *string                `protobuf:"bytes,8,opt,name=scope,proto3,oneof" json:"scope,omitempty"` // "team" (default) or "project"
-	Files         map[string]string      `protobuf:"bytes,9,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Scope         *string                `protobuf:"bytes,8,opt,name=scope,proto3,oneof" json:"scope,omitempty"`                                                                     // "team" (default) or "project"
+	Files         map[string]string      `protobuf:"bytes,9,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // UTF-8 text files only (legacy)
+	BundleFiles   map[string]*SkillFile  `protobuf:"bytes,10,rep,name=bundle_files,json=bundleFiles,proto3" json:"bundle_files,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateTeamSkillRequest) Reset() {
 	*x = CreateTeamSkillRequest{}
-	mi := &file_proto_sessionhub_proto_msgTypes[65]
+	mi := &file_proto_sessionhub_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4669,7 +5034,7 @@ func (x *CreateTeamSkillRequest) String() string {
 func (*CreateTeamSkillRequest) ProtoMessage() {}
 
 func (x *CreateTeamSkillRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[65]
+	mi := &file_proto_sessionhub_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4682,7 +5047,7 @@ func (x *CreateTeamSkillRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTeamSkillRequest.ProtoReflect.Descriptor instead.
 func (*CreateTeamSkillRequest) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{65}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *CreateTeamSkillRequest) GetTeamId() string {
@@ -4748,6 +5113,13 @@ func (x *CreateTeamSkillRequest) GetFiles() map[string]string {
 	return nil
 }
 
+func (x *CreateTeamSkillRequest) GetBundleFiles() map[string]*SkillFile {
+	if x != nil {
+		return x.BundleFiles
+	}
+	return nil
+}
+
 type CreateTeamSkillResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SkillId       string                 `protobuf:"bytes,1,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
@@ -4758,7 +5130,7 @@ type CreateTeamSkillResponse struct {
 
 func (x *CreateTeamSkillResponse) Reset() {
 	*x = CreateTeamSkillResponse{}
-	mi := &file_proto_sessionhub_proto_msgTypes[66]
+	mi := &file_proto_sessionhub_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4770,7 +5142,7 @@ func (x *CreateTeamSkillResponse) String() string {
 func (*CreateTeamSkillResponse) ProtoMessage() {}
 
 func (x *CreateTeamSkillResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_sessionhub_proto_msgTypes[66]
+	mi := &file_proto_sessionhub_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4783,7 +5155,7 @@ func (x *CreateTeamSkillResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTeamSkillResponse.ProtoReflect.Descriptor instead.
 func (*CreateTeamSkillResponse) Descriptor() ([]byte, []int) {
-	return file_proto_sessionhub_proto_rawDescGZIP(), []int{66}
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *CreateTeamSkillResponse) GetSkillId() string {
@@ -4800,6 +5172,190 @@ func (x *CreateTeamSkillResponse) GetSlug() string {
 	return ""
 }
 
+type UpdateTeamSkillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	SkillId       string                 `protobuf:"bytes,2,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Summary       *string                `protobuf:"bytes,5,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+	Category      *string                `protobuf:"bytes,6,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Files         map[string]string      `protobuf:"bytes,8,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // UTF-8 text files only (legacy)
+	BaseVersion   *int32                 `protobuf:"varint,9,opt,name=base_version,json=baseVersion,proto3,oneof" json:"base_version,omitempty"`                                     // Version the local copy was synced from (for conflict detection)
+	BundleFiles   map[string]*SkillFile  `protobuf:"bytes,10,rep,name=bundle_files,json=bundleFiles,proto3" json:"bundle_files,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamSkillRequest) Reset() {
+	*x = UpdateTeamSkillRequest{}
+	mi := &file_proto_sessionhub_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamSkillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamSkillRequest) ProtoMessage() {}
+
+func (x *UpdateTeamSkillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sessionhub_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamSkillRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamSkillRequest) Descriptor() ([]byte, []int) {
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateTeamSkillRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *UpdateTeamSkillRequest) GetSkillId() string {
+	if x != nil {
+		return x.SkillId
+	}
+	return ""
+}
+
+func (x *UpdateTeamSkillRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTeamSkillRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateTeamSkillRequest) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *UpdateTeamSkillRequest) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *UpdateTeamSkillRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateTeamSkillRequest) GetFiles() map[string]string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *UpdateTeamSkillRequest) GetBaseVersion() int32 {
+	if x != nil && x.BaseVersion != nil {
+		return *x.BaseVersion
+	}
+	return 0
+}
+
+func (x *UpdateTeamSkillRequest) GetBundleFiles() map[string]*SkillFile {
+	if x != nil {
+		return x.BundleFiles
+	}
+	return nil
+}
+
+type UpdateTeamSkillResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SkillId         string                 `protobuf:"bytes,1,opt,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
+	Slug            string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	PreviousVersion int32                  `protobuf:"varint,3,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"` // Currently published version
+	NewVersion      int32                  `protobuf:"varint,4,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`                // Version the draft will publish as once approved
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTeamSkillResponse) Reset() {
+	*x = UpdateTeamSkillResponse{}
+	mi := &file_proto_sessionhub_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamSkillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamSkillResponse) ProtoMessage() {}
+
+func (x *UpdateTeamSkillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_sessionhub_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamSkillResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTeamSkillResponse) Descriptor() ([]byte, []int) {
+	return file_proto_sessionhub_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpdateTeamSkillResponse) GetSkillId() string {
+	if x != nil {
+		return x.SkillId
+	}
+	return ""
+}
+
+func (x *UpdateTeamSkillResponse) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateTeamSkillResponse) GetPreviousVersion() int32 {
+	if x != nil {
+		return x.PreviousVersion
+	}
+	return 0
+}
+
+func (x *UpdateTeamSkillResponse) GetNewVersion() int32 {
+	if x != nil {
+		return x.NewVersion
+	}
+	return 0
+}
+
 var File_proto_sessionhub_proto protoreflect.FileDescriptor
 
 const file_proto_sessionhub_proto_rawDesc = "" +
@@ -4859,7 +5415,7 @@ const file_proto_sessionhub_proto_rawDesc = "" +
 	"\x12_github_repo_ownerB\x11\n" +
 	"\x0f_github_repo_idB\x18\n" +
 	"\x16_github_default_branchB\x16\n" +
-	"\x14_github_connected_at\"\xe0\v\n" +
+	"\x14_github_connected_at\"\x9b\f\n" +
 	"\x14CreateSessionRequest\x12!\n" +
 	"\fproject_name\x18\x01 \x01(\tR\vprojectName\x12&\n" +
 	"\fproject_path\x18\x02 \x01(\tH\x00R\vprojectPath\x88\x01\x01\x12\x1d\n" +
@@ -4888,7 +5444,8 @@ const file_proto_sessionhub_proto_rawDesc = "" +
 	"\x16encrypted_sub_sessions\x18\x18 \x01(\tH\n" +
 	"R\x14encryptedSubSessions\x88\x01\x01\x12?\n" +
 	"\x19encrypted_attachment_urls\x18\x19 \x01(\tH\vR\x17encryptedAttachmentUrls\x88\x01\x01\x12 \n" +
-	"\tplan_slug\x18\x1a \x01(\tH\fR\bplanSlug\x88\x01\x01\x1a;\n" +
+	"\tplan_slug\x18\x1a \x01(\tH\fR\bplanSlug\x88\x01\x01\x129\n" +
+	"\ffile_changes\x18\x1b \x03(\v2\x16.sessionhub.FileChangeR\vfileChanges\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0f\n" +
@@ -4923,12 +5480,39 @@ const file_proto_sessionhub_proto_rawDesc = "" +
 	"\x16observations_triggered\x18\a \x01(\bR\x15observationsTriggered\"2\n" +
 	"\x11GetSessionRequest\x12\x1d\n" +
 	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"b\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\xb3\x02\n" +
+	"\x13ListSessionsRequest\x12\"\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tH\x00R\tprojectId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"git_branch\x18\x02 \x01(\tH\x01R\tgitBranch\x88\x01\x01\x12$\n" +
+	"\vstart_after\x18\x03 \x01(\tH\x02R\n" +
+	"startAfter\x88\x01\x01\x12&\n" +
+	"\fstart_before\x18\x04 \x01(\tH\x03R\vstartBefore\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\"\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tH\x04R\tpageToken\x88\x01\x01B\r\n" +
+	"\v_project_idB\r\n" +
+	"\v_git_branchB\x0e\n" +
+	"\f_start_afterB\x0f\n" +
+	"\r_start_beforeB\r\n" +
+	"\v_page_token\"\x88\x01\n" +
+	"\x14ListSessionsResponse\x12/\n" +
+	"\bsessions\x18\x01 \x03(\v2\x13.sessionhub.SessionR\bsessions\x12+\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tH\x00R\rnextPageToken\x88\x01\x01B\x12\n" +
+	"\x10_next_page_token\"\xdb\x01\n" +
 	"\x14UpdateSessionRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1e\n" +
-	"\bend_time\x18\x02 \x01(\tH\x00R\aendTime\x88\x01\x01B\v\n" +
-	"\t_end_time\"\xdb\x06\n" +
+	"\bend_time\x18\x02 \x01(\tH\x00R\aendTime\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x04 \x01(\tH\x02R\x04type\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x1f\n" +
+	"\vupdate_tags\x18\x06 \x01(\bR\n" +
+	"updateTagsB\v\n" +
+	"\t_end_timeB\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_type\"\x91\a\n" +
 	"\aSession\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -4953,14 +5537,17 @@ const file_proto_sessionhub_proto_rawDesc = "" +
 	"created_at\x18\x11 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\x12 \x01(\tR\tupdatedAt\x12/\n" +
-	"\x11sub_sessions_json\x18\x13 \x01(\tH\x03R\x0fsubSessionsJson\x88\x01\x01\x1a;\n" +
+	"\x11sub_sessions_json\x18\x13 \x01(\tH\x03R\x0fsubSessionsJson\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x14 \x01(\tH\x04R\x04type\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x15 \x03(\tR\x04tags\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\v\n" +
 	"\t_end_timeB\a\n" +
 	"\x05_nameB\r\n" +
 	"\v_git_branchB\x14\n" +
-	"\x12_sub_sessions_jsonJ\x04\b\x0e\x10\x0fR\x05plans\"y\n" +
+	"\x12_sub_sessions_jsonB\a\n" +
+	"\x05_typeJ\x04\b\x0e\x10\x0fR\x05plans\"y\n" +
 	"\x19StreamInteractionsRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12=\n" +
@@ -4993,7 +5580,20 @@ const file_proto_sessionhub_proto_rawDesc = "" +
 	"\n" +
 	"_tool_nameB\x0f\n" +
 	"\r_input_tokensB\x10\n" +
-	"\x0e_output_tokens\"T\n" +
+	"\x0e_output_tokens\"\x82\x02\n" +
+	"\n" +
+	"FileChange\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1f\n" +
+	"\vlines_added\x18\x02 \x01(\x05R\n" +
+	"linesAdded\x12#\n" +
+	"\rlines_removed\x18\x03 \x01(\x05R\flinesRemoved\x12\x1d\n" +
+	"\n" +
+	"edit_count\x18\x04 \x01(\x05R\teditCount\x12\x18\n" +
+	"\acreated\x18\x05 \x01(\bR\acreated\x12\x17\n" +
+	"\x04diff\x18\x06 \x01(\tH\x00R\x04diff\x88\x01\x01\x12%\n" +
+	"\x0ediff_truncated\x18\a \x01(\bR\rdiffTruncated\x12\x18\n" +
+	"\apartial\x18\b \x01(\bR\apartialB\a\n" +
+	"\x05_diff\"T\n" +
 	"\fTodoSnapshot\x12\x1c\n" +
 	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12&\n" +
 	"\x05todos\x18\x02 \x03(\v2\x10.sessionhub.TodoR\x05todos\"Y\n" +
@@ -5252,7 +5852,7 @@ const file_proto_sessionhub_proto_rawDesc = "" +
 	"project_id\x18\x02 \x01(\tH\x00R\tprojectId\x88\x01\x01\x12\x19\n" +
 	"\x05scope\x18\x03 \x01(\tH\x01R\x05scope\x88\x01\x01B\r\n" +
 	"\v_project_idB\b\n" +
-	"\x06_scope\"\xe0\x03\n" +
+	"\x06_scope\"\x87\x05\n" +
 	"\x0eTeamSkillProto\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x14\n" +
@@ -5267,17 +5867,27 @@ const file_proto_sessionhub_proto_rawDesc = "" +
 	" \x01(\tH\x01R\x0flastPublishedAt\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"project_id\x18\v \x01(\tH\x02R\tprojectId\x88\x01\x01\x12;\n" +
-	"\x05files\x18\f \x03(\v2%.sessionhub.TeamSkillProto.FilesEntryR\x05files\x1a8\n" +
+	"\x05files\x18\f \x03(\v2%.sessionhub.TeamSkillProto.FilesEntryR\x05files\x12N\n" +
+	"\fbundle_files\x18\r \x03(\v2+.sessionhub.TeamSkillProto.BundleFilesEntryR\vbundleFiles\x1a8\n" +
 	"\n" +
 	"FilesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aU\n" +
+	"\x10BundleFilesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.sessionhub.SkillFileR\x05value:\x028\x01B\n" +
 	"\n" +
 	"\b_summaryB\x14\n" +
 	"\x12_last_published_atB\r\n" +
-	"\v_project_id\"K\n" +
+	"\v_project_id\"h\n" +
+	"\tSkillFile\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1e\n" +
+	"\n" +
+	"executable\x18\x03 \x01(\bR\n" +
+	"executable\"K\n" +
 	"\x15GetTeamSkillsResponse\x122\n" +
-	"\x06skills\x18\x01 \x03(\v2\x1a.sessionhub.TeamSkillProtoR\x06skills\"\xa5\x03\n" +
+	"\x06skills\x18\x01 \x03(\v2\x1a.sessionhub.TeamSkillProtoR\x06skills\"\xd4\x04\n" +
 	"\x16CreateTeamSkillRequest\x12\x17\n" +
 	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\"\n" +
 	"\n" +
@@ -5288,11 +5898,16 @@ const file_proto_sessionhub_proto_rawDesc = "" +
 	"\bcategory\x18\x06 \x01(\tH\x02R\bcategory\x88\x01\x01\x12\x12\n" +
 	"\x04tags\x18\a \x03(\tR\x04tags\x12\x19\n" +
 	"\x05scope\x18\b \x01(\tH\x03R\x05scope\x88\x01\x01\x12C\n" +
-	"\x05files\x18\t \x03(\v2-.sessionhub.CreateTeamSkillRequest.FilesEntryR\x05files\x1a8\n" +
+	"\x05files\x18\t \x03(\v2-.sessionhub.CreateTeamSkillRequest.FilesEntryR\x05files\x12V\n" +
+	"\fbundle_files\x18\n" +
+	" \x03(\v23.sessionhub.CreateTeamSkillRequest.BundleFilesEntryR\vbundleFiles\x1a8\n" +
 	"\n" +
 	"FilesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aU\n" +
+	"\x10BundleFilesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.sessionhub.SkillFileR\x05value:\x028\x01B\r\n" +
 	"\v_project_idB\n" +
 	"\n" +
 	"\b_summaryB\v\n" +
@@ -5300,7 +5915,36 @@ const file_proto_sessionhub_proto_rawDesc = "" +
 	"\x06_scope\"H\n" +
 	"\x17CreateTeamSkillResponse\x12\x19\n" +
 	"\bskill_id\x18\x01 \x01(\tR\askillId\x12\x12\n" +
-	"\x04slug\x18\x02 \x01(\tR\x04slug*{\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"\xd0\x04\n" +
+	"\x16UpdateTeamSkillRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x19\n" +
+	"\bskill_id\x18\x02 \x01(\tR\askillId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1d\n" +
+	"\asummary\x18\x05 \x01(\tH\x00R\asummary\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\x06 \x01(\tH\x01R\bcategory\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12C\n" +
+	"\x05files\x18\b \x03(\v2-.sessionhub.UpdateTeamSkillRequest.FilesEntryR\x05files\x12&\n" +
+	"\fbase_version\x18\t \x01(\x05H\x02R\vbaseVersion\x88\x01\x01\x12V\n" +
+	"\fbundle_files\x18\n" +
+	" \x03(\v23.sessionhub.UpdateTeamSkillRequest.BundleFilesEntryR\vbundleFiles\x1a8\n" +
+	"\n" +
+	"FilesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aU\n" +
+	"\x10BundleFilesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.sessionhub.SkillFileR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_summaryB\v\n" +
+	"\t_categoryB\x0f\n" +
+	"\r_base_version\"\x94\x01\n" +
+	"\x17UpdateTeamSkillResponse\x12\x19\n" +
+	"\bskill_id\x18\x01 \x01(\tR\askillId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12)\n" +
+	"\x10previous_version\x18\x03 \x01(\x05R\x0fpreviousVersion\x12\x1f\n" +
+	"\vnew_version\x18\x04 \x01(\x05R\n" +
+	"newVersion*{\n" +
 	"\bTeamRole\x12\x19\n" +
 	"\x15TEAM_ROLE_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fTEAM_ROLE_OWNER\x10\x01\x12\x13\n" +
@@ -5311,7 +5955,7 @@ const file_proto_sessionhub_proto_rawDesc = "" +
 	"\x15TEAM_PLAN_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11TEAM_PLAN_STARTER\x10\x01\x12\x11\n" +
 	"\rTEAM_PLAN_PRO\x10\x02\x12\x18\n" +
-	"\x14TEAM_PLAN_ENTERPRISE\x10\x032\xa6\x15\n" +
+	"\x14TEAM_PLAN_ENTERPRISE\x10\x032\xd5\x16\n" +
 	"\x11SessionHubService\x12W\n" +
 	"\x0eValidateApiKey\x12!.sessionhub.ValidateApiKeyRequest\x1a\".sessionhub.ValidateApiKeyResponse\x12N\n" +
 	"\vGetProjects\x12\x1e.sessionhub.GetProjectsRequest\x1a\x1f.sessionhub.GetProjectsResponse\x12F\n" +
@@ -5320,7 +5964,8 @@ const file_proto_sessionhub_proto_rawDesc = "" +
 	"\rUpsertSession\x12 .sessionhub.CreateSessionRequest\x1a!.sessionhub.UpsertSessionResponse\x12@\n" +
 	"\n" +
 	"GetSession\x12\x1d.sessionhub.GetSessionRequest\x1a\x13.sessionhub.Session\x12F\n" +
-	"\rUpdateSession\x12 .sessionhub.UpdateSessionRequest\x1a\x13.sessionhub.Session\x12e\n" +
+	"\rUpdateSession\x12 .sessionhub.UpdateSessionRequest\x1a\x13.sessionhub.Session\x12Q\n" +
+	"\fListSessions\x12\x1f.sessionhub.ListSessionsRequest\x1a .sessionhub.ListSessionsResponse\x12e\n" +
 	"\x12StreamInteractions\x12%.sessionhub.StreamInteractionsRequest\x1a&.sessionhub.StreamInteractionsResponse(\x01\x12i\n" +
 	"\x14AddInteractionsBatch\x12'.sessionhub.AddInteractionsBatchRequest\x1a(.sessionhub.AddInteractionsBatchResponse\x12o\n" +
 	"\x16GetProjectObservations\x12).sessionhub.GetProjectObservationsRequest\x1a*.sessionhub.GetProjectObservationsResponse\x12c\n" +
@@ -5347,7 +5992,8 @@ const file_proto_sessionhub_proto_rawDesc = "" +
 	"\x10GetTeamPublicKey\x12#.sessionhub.GetTeamPublicKeyRequest\x1a$.sessionhub.GetTeamPublicKeyResponse\x12]\n" +
 	"\x10GetUserPublicKey\x12#.sessionhub.GetUserPublicKeyRequest\x1a$.sessionhub.GetUserPublicKeyResponse\x12T\n" +
 	"\rGetTeamSkills\x12 .sessionhub.GetTeamSkillsRequest\x1a!.sessionhub.GetTeamSkillsResponse\x12Z\n" +
-	"\x0fCreateTeamSkill\x12\".sessionhub.CreateTeamSkillRequest\x1a#.sessionhub.CreateTeamSkillResponseB\"Z github.com/vibelog/backend/protob\x06proto3"
+	"\x0fCreateTeamSkill\x12\".sessionhub.CreateTeamSkillRequest\x1a#.sessionhub.CreateTeamSkillResponse\x12Z\n" +
+	"\x0fUpdateTeamSkill\x12\".sessionhub.UpdateTeamSkillRequest\x1a#.sessionhub.UpdateTeamSkillResponseB\"Z github.com/vibelog/backend/protob\x06proto3"
 
 var (
 	file_proto_sessionhub_proto_rawDescOnce sync.Once
@@ -5362,7 +6008,7 @@ func file_proto_sessionhub_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_sessionhub_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_sessionhub_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
+var file_proto_sessionhub_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
 var file_proto_sessionhub_proto_goTypes = []any{
 	(TeamRole)(0),                          // 0: sessionhub.TeamRole
 	(TeamPlan)(0),                          // 1: sessionhub.TeamPlan
@@ -5376,169 +6022,192 @@ var file_proto_sessionhub_proto_goTypes = []any{
 	(*CreateSessionResponse)(nil),          // 9: sessionhub.CreateSessionResponse
 	(*UpsertSessionResponse)(nil),          // 10: sessionhub.UpsertSessionResponse
 	(*GetSessionRequest)(nil),              // 11: sessionhub.GetSessionRequest
-	(*UpdateSessionRequest)(nil),           // 12: sessionhub.UpdateSessionRequest
-	(*Session)(nil),                        // 13: sessionhub.Session
-	(*StreamInteractionsRequest)(nil),      // 14: sessionhub.StreamInteractionsRequest
-	(*StreamInteractionsResponse)(nil),     // 15: sessionhub.StreamInteractionsResponse
-	(*AddInteractionsBatchRequest)(nil),    // 16: sessionhub.AddInteractionsBatchRequest
-	(*AddInteractionsBatchResponse)(nil),   // 17: sessionhub.AddInteractionsBatchResponse
-	(*InteractionData)(nil),                // 18: sessionhub.InteractionData
-	(*TodoSnapshot)(nil),                   // 19: sessionhub.TodoSnapshot
-	(*Todo)(nil),                           // 20: sessionhub.Todo
-	(*AttachmentMetadata)(nil),             // 21: sessionhub.AttachmentMetadata
-	(*GetProjectObservationsRequest)(nil),  // 22: sessionhub.GetProjectObservationsRequest
-	(*GetProjectObservationsResponse)(nil), // 23: sessionhub.GetProjectObservationsResponse
-	(*Observation)(nil),                    // 24: sessionhub.Observation
-	(*GetUserPreferencesRequest)(nil),      // 25: sessionhub.GetUserPreferencesRequest
-	(*GetUserPreferencesResponse)(nil),     // 26: sessionhub.GetUserPreferencesResponse
-	(*UploadAttachmentRequest)(nil),        // 27: sessionhub.UploadAttachmentRequest
-	(*UploadAttachmentResponse)(nil),       // 28: sessionhub.UploadAttachmentResponse
-	(*UploadPlanFileRequest)(nil),          // 29: sessionhub.UploadPlanFileRequest
-	(*UploadPlanFileResponse)(nil),         // 30: sessionhub.UploadPlanFileResponse
-	(*GetSessionQuotaRequest)(nil),         // 31: sessionhub.GetSessionQuotaRequest
-	(*GetSessionQuotaResponse)(nil),        // 32: sessionhub.GetSessionQuotaResponse
-	(*Team)(nil),                           // 33: sessionhub.Team
-	(*TeamMember)(nil),                     // 34: sessionhub.TeamMember
-	(*TeamInvitation)(nil),                 // 35: sessionhub.TeamInvitation
-	(*TeamSubscription)(nil),               // 36: sessionhub.TeamSubscription
-	(*CreateTeamRequest)(nil),              // 37: sessionhub.CreateTeamRequest
-	(*GetTeamRequest)(nil),                 // 38: sessionhub.GetTeamRequest
-	(*UpdateTeamRequest)(nil),              // 39: sessionhub.UpdateTeamRequest
-	(*DeleteTeamRequest)(nil),              // 40: sessionhub.DeleteTeamRequest
-	(*DeleteTeamResponse)(nil),             // 41: sessionhub.DeleteTeamResponse
-	(*ListUserTeamsRequest)(nil),           // 42: sessionhub.ListUserTeamsRequest
-	(*ListUserTeamsResponse)(nil),          // 43: sessionhub.ListUserTeamsResponse
-	(*InviteMemberRequest)(nil),            // 44: sessionhub.InviteMemberRequest
-	(*InviteMemberResponse)(nil),           // 45: sessionhub.InviteMemberResponse
-	(*AcceptInvitationRequest)(nil),        // 46: sessionhub.AcceptInvitationRequest
-	(*AcceptInvitationResponse)(nil),       // 47: sessionhub.AcceptInvitationResponse
-	(*RevokeInvitationRequest)(nil),        // 48: sessionhub.RevokeInvitationRequest
-	(*RevokeInvitationResponse)(nil),       // 49: sessionhub.RevokeInvitationResponse
-	(*ListPendingInvitationsRequest)(nil),  // 50: sessionhub.ListPendingInvitationsRequest
-	(*ListPendingInvitationsResponse)(nil), // 51: sessionhub.ListPendingInvitationsResponse
-	(*RemoveMemberRequest)(nil),            // 52: sessionhub.RemoveMemberRequest
-	(*RemoveMemberResponse)(nil),           // 53: sessionhub.RemoveMemberResponse
-	(*UpdateMemberRoleRequest)(nil),        // 54: sessionhub.UpdateMemberRoleRequest
-	(*UpdateMemberRoleResponse)(nil),       // 55: sessionhub.UpdateMemberRoleResponse
-	(*ListMembersRequest)(nil),             // 56: sessionhub.ListMembersRequest
-	(*ListMembersResponse)(nil),            // 57: sessionhub.ListMembersResponse
-	(*TransferOwnershipRequest)(nil),       // 58: sessionhub.TransferOwnershipRequest
-	(*TransferOwnershipResponse)(nil),      // 59: sessionhub.TransferOwnershipResponse
-	(*GetTeamPublicKeyRequest)(nil),        // 60: sessionhub.GetTeamPublicKeyRequest
-	(*GetTeamPublicKeyResponse)(nil),       // 61: sessionhub.GetTeamPublicKeyResponse
-	(*GetUserPublicKeyRequest)(nil),        // 62: sessionhub.GetUserPublicKeyRequest
-	(*GetUserPublicKeyResponse)(nil),       // 63: sessionhub.GetUserPublicKeyResponse
-	(*GetTeamSkillsRequest)(nil),           // 64: sessionhub.GetTeamSkillsRequest
-	(*TeamSkillProto)(nil),                 // 65: sessionhub.TeamSkillProto
-	(*GetTeamSkillsResponse)(nil),          // 66: sessionhub.GetTeamSkillsResponse
-	(*CreateTeamSkillRequest)(nil),         // 67: sessionhub.CreateTeamSkillRequest
-	(*CreateTeamSkillResponse)(nil),        // 68: sessionhub.CreateTeamSkillResponse
-	nil,                                    // 69: sessionhub.CreateProjectRequest.MetadataEntry
-	nil,                                    // 70: sessionhub.Project.MetadataEntry
-	nil,                                    // 71: sessionhub.CreateSessionRequest.MetadataEntry
-	nil,                                    // 72: sessionhub.Session.MetadataEntry
-	nil,                                    // 73: sessionhub.InteractionData.MetadataEntry
-	nil,                                    // 74: sessionhub.TeamSkillProto.FilesEntry
-	nil,                                    // 75: sessionhub.CreateTeamSkillRequest.FilesEntry
+	(*ListSessionsRequest)(nil),            // 12: sessionhub.ListSessionsRequest
+	(*ListSessionsResponse)(nil),           // 13: sessionhub.ListSessionsResponse
+	(*UpdateSessionRequest)(nil),           // 14: sessionhub.UpdateSessionRequest
+	(*Session)(nil),                        // 15: sessionhub.Session
+	(*StreamInteractionsRequest)(nil),      // 16: sessionhub.StreamInteractionsRequest
+	(*StreamInteractionsResponse)(nil),     // 17: sessionhub.StreamInteractionsResponse
+	(*AddInteractionsBatchRequest)(nil),    // 18: sessionhub.AddInteractionsBatchRequest
+	(*AddInteractionsBatchResponse)(nil),   // 19: sessionhub.AddInteractionsBatchResponse
+	(*InteractionData)(nil),                // 20: sessionhub.InteractionData
+	(*FileChange)(nil),                     // 21: sessionhub.FileChange
+	(*TodoSnapshot)(nil),                   // 22: sessionhub.TodoSnapshot
+	(*Todo)(nil),                           // 23: sessionhub.Todo
+	(*AttachmentMetadata)(nil),             // 24: sessionhub.AttachmentMetadata
+	(*GetProjectObservationsRequest)(nil),  // 25: sessionhub.GetProjectObservationsRequest
+	(*GetProjectObservationsResponse)(nil), // 26: sessionhub.GetProjectObservationsResponse
+	(*Observation)(nil),                    // 27: sessionhub.Observation
+	(*GetUserPreferencesRequest)(nil),      // 28: sessionhub.GetUserPreferencesRequest
+	(*GetUserPreferencesResponse)(nil),     // 29: sessionhub.GetUserPreferencesResponse
+	(*UploadAttachmentRequest)(nil),        // 30: sessionhub.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),       // 31: sessionhub.UploadAttachmentResponse
+	(*UploadPlanFileRequest)(nil),          // 32: sessionhub.UploadPlanFileRequest
+	(*UploadPlanFileResponse)(nil),         // 33: sessionhub.UploadPlanFileResponse
+	(*GetSessionQuotaRequest)(nil),         // 34: sessionhub.GetSessionQuotaRequest
+	(*GetSessionQuotaResponse)(nil),        // 35: sessionhub.GetSessionQuotaResponse
+	(*Team)(nil),                           // 36: sessionhub.Team
+	(*TeamMember)(nil),                     // 37: sessionhub.TeamMember
+	(*TeamInvitation)(nil),                 // 38: sessionhub.TeamInvitation
+	(*TeamSubscription)(nil),               // 39: sessionhub.TeamSubscription
+	(*CreateTeamRequest)(nil),              // 40: sessionhub.CreateTeamRequest
+	(*GetTeamRequest)(nil),                 // 41: sessionhub.GetTeamRequest
+	(*UpdateTeamRequest)(nil),              // 42: sessionhub.UpdateTeamRequest
+	(*DeleteTeamRequest)(nil),              // 43: sessionhub.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),             // 44: sessionhub.DeleteTeamResponse
+	(*ListUserTeamsRequest)(nil),           // 45: sessionhub.ListUserTeamsRequest
+	(*ListUserTeamsResponse)(nil),          // 46: sessionhub.ListUserTeamsResponse
+	(*InviteMemberRequest)(nil),            // 47: sessionhub.InviteMemberRequest
+	(*InviteMemberResponse)(nil),           // 48: sessionhub.InviteMemberResponse
+	(*AcceptInvitationRequest)(nil),        // 49: sessionhub.AcceptInvitationRequest
+	(*AcceptInvitationResponse)(nil),       // 50: sessionhub.AcceptInvitationResponse
+	(*RevokeInvitationRequest)(nil),        // 51: sessionhub.RevokeInvitationRequest
+	(*RevokeInvitationResponse)(nil),       // 52: sessionhub.RevokeInvitationResponse
+	(*ListPendingInvitationsRequest)(nil),  // 53: sessionhub.ListPendingInvitationsRequest
+	(*ListPendingInvitationsResponse)(nil), // 54: sessionhub.ListPendingInvitationsResponse
+	(*RemoveMemberRequest)(nil),            // 55: sessionhub.RemoveMemberRequest
+	(*RemoveMemberResponse)(nil),           // 56: sessionhub.RemoveMemberResponse
+	(*UpdateMemberRoleRequest)(nil),        // 57: sessionhub.UpdateMemberRoleRequest
+	(*UpdateMemberRoleResponse)(nil),       // 58: sessionhub.UpdateMemberRoleResponse
+	(*ListMembersRequest)(nil),             // 59: sessionhub.ListMembersRequest
+	(*ListMembersResponse)(nil),            // 60: sessionhub.ListMembersResponse
+	(*TransferOwnershipRequest)(nil),       // 61: sessionhub.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil),      // 62: sessionhub.TransferOwnershipResponse
+	(*GetTeamPublicKeyRequest)(nil),        // 63: sessionhub.GetTeamPublicKeyRequest
+	(*GetTeamPublicKeyResponse)(nil),       // 64: sessionhub.GetTeamPublicKeyResponse
+	(*GetUserPublicKeyRequest)(nil),        // 65: sessionhub.GetUserPublicKeyRequest
+	(*GetUserPublicKeyResponse)(nil),       // 66: sessionhub.GetUserPublicKeyResponse
+	(*GetTeamSkillsRequest)(nil),           // 67: sessionhub.GetTeamSkillsRequest
+	(*TeamSkillProto)(nil),                 // 68: sessionhub.TeamSkillProto
+	(*SkillFile)(nil),                      // 69: sessionhub.SkillFile
+	(*GetTeamSkillsResponse)(nil),          // 70: sessionhub.GetTeamSkillsResponse
+	(*CreateTeamSkillRequest)(nil),         // 71: sessionhub.CreateTeamSkillRequest
+	(*CreateTeamSkillResponse)(nil),        // 72: sessionhub.CreateTeamSkillResponse
+	(*UpdateTeamSkillRequest)(nil),         // 73: sessionhub.UpdateTeamSkillRequest
+	(*UpdateTeamSkillResponse)(nil),        // 74: sessionhub.UpdateTeamSkillResponse
+	nil,                                    // 75: sessionhub.CreateProjectRequest.MetadataEntry
+	nil,                                    // 76: sessionhub.Project.MetadataEntry
+	nil,                                    // 77: sessionhub.CreateSessionRequest.MetadataEntry
+	nil,                                    // 78: sessionhub.Session.MetadataEntry
+	nil,                                    // 79: sessionhub.InteractionData.MetadataEntry
+	nil,                                    // 80: sessionhub.TeamSkillProto.FilesEntry
+	nil,                                    // 81: sessionhub.TeamSkillProto.BundleFilesEntry
+	nil,                                    // 82: sessionhub.CreateTeamSkillRequest.FilesEntry
+	nil,                                    // 83: sessionhub.CreateTeamSkillRequest.BundleFilesEntry
+	nil,                                    // 84: sessionhub.UpdateTeamSkillRequest.FilesEntry
+	nil,                                    // 85: sessionhub.UpdateTeamSkillRequest.BundleFilesEntry
 }
 var file_proto_sessionhub_proto_depIdxs = []int32{
 	7,  // 0: sessionhub.GetProjectsResponse.projects:type_name -> sessionhub.Project
-	69, // 1: sessionhub.CreateProjectRequest.metadata:type_name -> sessionhub.CreateProjectRequest.MetadataEntry
-	70, // 2: sessionhub.Project.metadata:type_name -> sessionhub.Project.MetadataEntry
-	19, // 3: sessionhub.CreateSessionRequest.todo_snapshots:type_name -> sessionhub.TodoSnapshot
-	21, // 4: sessionhub.CreateSessionRequest.attachment_urls:type_name -> sessionhub.AttachmentMetadata
-	18, // 5: sessionhub.CreateSessionRequest.interactions:type_name -> sessionhub.InteractionData
-	71, // 6: sessionhub.CreateSessionRequest.metadata:type_name -> sessionhub.CreateSessionRequest.MetadataEntry
-	19, // 7: sessionhub.Session.todo_snapshots:type_name -> sessionhub.TodoSnapshot
-	21, // 8: sessionhub.Session.attachment_urls:type_name -> sessionhub.AttachmentMetadata
-	72, // 9: sessionhub.Session.metadata:type_name -> sessionhub.Session.MetadataEntry
-	18, // 10: sessionhub.StreamInteractionsRequest.interaction:type_name -> sessionhub.InteractionData
-	18, // 11: sessionhub.AddInteractionsBatchRequest.interactions:type_name -> sessionhub.InteractionData
-	73, // 12: sessionhub.InteractionData.metadata:type_name -> sessionhub.InteractionData.MetadataEntry
-	20, // 13: sessionhub.TodoSnapshot.todos:type_name -> sessionhub.Todo
-	24, // 14: sessionhub.GetProjectObservationsResponse.observations:type_name -> sessionhub.Observation
-	0,  // 15: sessionhub.Team.current_user_role:type_name -> sessionhub.TeamRole
-	36, // 16: sessionhub.Team.subscription:type_name -> sessionhub.TeamSubscription
-	0,  // 17: sessionhub.TeamMember.role:type_name -> sessionhub.TeamRole
-	0,  // 18: sessionhub.TeamInvitation.role:type_name -> sessionhub.TeamRole
-	1,  // 19: sessionhub.TeamSubscription.plan:type_name -> sessionhub.TeamPlan
-	33, // 20: sessionhub.ListUserTeamsResponse.teams:type_name -> sessionhub.Team
-	0,  // 21: sessionhub.InviteMemberRequest.role:type_name -> sessionhub.TeamRole
-	0,  // 22: sessionhub.AcceptInvitationResponse.role:type_name -> sessionhub.TeamRole
-	35, // 23: sessionhub.ListPendingInvitationsResponse.invitations:type_name -> sessionhub.TeamInvitation
-	0,  // 24: sessionhub.UpdateMemberRoleRequest.new_role:type_name -> sessionhub.TeamRole
-	34, // 25: sessionhub.UpdateMemberRoleResponse.member:type_name -> sessionhub.TeamMember
-	34, // 26: sessionhub.ListMembersResponse.members:type_name -> sessionhub.TeamMember
-	74, // 27: sessionhub.TeamSkillProto.files:type_name -> sessionhub.TeamSkillProto.FilesEntry
-	65, // 28: sessionhub.GetTeamSkillsResponse.skills:type_name -> sessionhub.TeamSkillProto
-	75, // 29: sessionhub.CreateTeamSkillRequest.files:type_name -> sessionhub.CreateTeamSkillRequest.FilesEntry
-	2,  // 30: sessionhub.SessionHubService.ValidateApiKey:input_type -> sessionhub.ValidateApiKeyRequest
-	4,  // 31: sessionhub.SessionHubService.GetProjects:input_type -> sessionhub.GetProjectsRequest
-	6,  // 32: sessionhub.SessionHubService.CreateProject:input_type -> sessionhub.CreateProjectRequest
-	8,  // 33: sessionhub.SessionHubService.CreateSession:input_type -> sessionhub.CreateSessionRequest
-	8,  // 34: sessionhub.SessionHubService.UpsertSession:input_type -> sessionhub.CreateSessionRequest
-	11, // 35: sessionhub.SessionHubService.GetSession:input_type -> sessionhub.GetSessionRequest
-	12, // 36: sessionhub.SessionHubService.UpdateSession:input_type -> sessionhub.UpdateSessionRequest
-	14, // 37: sessionhub.SessionHubService.StreamInteractions:input_type -> sessionhub.StreamInteractionsRequest
-	16, // 38: sessionhub.SessionHubService.AddInteractionsBatch:input_type -> sessionhub.AddInteractionsBatchRequest
-	22, // 39: sessionhub.SessionHubService.GetProjectObservations:input_type -> sessionhub.GetProjectObservationsRequest
-	25, // 40: sessionhub.SessionHubService.GetUserPreferences:input_type -> sessionhub.GetUserPreferencesRequest
-	27, // 41: sessionhub.SessionHubService.UploadAttachment:input_type -> sessionhub.UploadAttachmentRequest
-	29, // 42: sessionhub.SessionHubService.UploadPlanFile:input_type -> sessionhub.UploadPlanFileRequest
-	31, // 43: sessionhub.SessionHubService.GetSessionQuota:input_type -> sessionhub.GetSessionQuotaRequest
-	37, // 44: sessionhub.SessionHubService.CreateTeam:input_type -> sessionhub.CreateTeamRequest
-	38, // 45: sessionhub.SessionHubService.GetTeam:input_type -> sessionhub.GetTeamRequest
-	39, // 46: sessionhub.SessionHubService.UpdateTeam:input_type -> sessionhub.UpdateTeamRequest
-	40, // 47: sessionhub.SessionHubService.DeleteTeam:input_type -> sessionhub.DeleteTeamRequest
-	42, // 48: sessionhub.SessionHubService.ListUserTeams:input_type -> sessionhub.ListUserTeamsRequest
-	44, // 49: sessionhub.SessionHubService.InviteMember:input_type -> sessionhub.InviteMemberRequest
-	46, // 50: sessionhub.SessionHubService.AcceptInvitation:input_type -> sessionhub.AcceptInvitationRequest
-	48, // 51: sessionhub.SessionHubService.RevokeInvitation:input_type -> sessionhub.RevokeInvitationRequest
-	50, // 52: sessionhub.SessionHubService.ListPendingInvitations:input_type -> sessionhub.ListPendingInvitationsRequest
-	52, // 53: sessionhub.SessionHubService.RemoveMember:input_type -> sessionhub.RemoveMemberRequest
-	54, // 54: sessionhub.SessionHubService.UpdateMemberRole:input_type -> sessionhub.UpdateMemberRoleRequest
-	56, // 55: sessionhub.SessionHubService.ListMembers:input_type -> sessionhub.ListMembersRequest
-	58, // 56: sessionhub.SessionHubService.TransferOwnership:input_type -> sessionhub.TransferOwnershipRequest
-	60, // 57: sessionhub.SessionHubService.GetTeamPublicKey:input_type -> sessionhub.GetTeamPublicKeyRequest
-	62, // 58: sessionhub.SessionHubService.GetUserPublicKey:input_type -> sessionhub.GetUserPublicKeyRequest
-	64, // 59: sessionhub.SessionHubService.GetTeamSkills:input_type -> sessionhub.GetTeamSkillsRequest
-	67, // 60: sessionhub.SessionHubService.CreateTeamSkill:input_type -> sessionhub.CreateTeamSkillRequest
-	3,  // 61: sessionhub.SessionHubService.ValidateApiKey:output_type -> sessionhub.ValidateApiKeyResponse
-	5,  // 62: sessionhub.SessionHubService.GetProjects:output_type -> sessionhub.GetProjectsResponse
-	7,  // 63: sessionhub.SessionHubService.CreateProject:output_type -> sessionhub.Project
-	9,  // 64: sessionhub.SessionHubService.CreateSession:output_type -> sessionhub.CreateSessionResponse
-	10, // 65: sessionhub.SessionHubService.UpsertSession:output_type -> sessionhub.UpsertSessionResponse
-	13, // 66: sessionhub.SessionHubService.GetSession:output_type -> sessionhub.Session
-	13, // 67: sessionhub.SessionHubService.UpdateSession:output_type -> sessionhub.Session
-	15, // 68: sessionhub.SessionHubService.StreamInteractions:output_type -> sessionhub.StreamInteractionsResponse
-	17, // 69: sessionhub.SessionHubService.AddInteractionsBatch:output_type -> sessionhub.AddInteractionsBatchResponse
-	23, // 70: sessionhub.SessionHubService.GetProjectObservations:output_type -> sessionhub.GetProjectObservationsResponse
-	26, // 71: sessionhub.SessionHubService.GetUserPreferences:output_type -> sessionhub.GetUserPreferencesResponse
-	28, // 72: sessionhub.SessionHubService.UploadAttachment:output_type -> sessionhub.UploadAttachmentResponse
-	30, // 73: sessionhub.SessionHubService.UploadPlanFile:output_type -> sessionhub.UploadPlanFileResponse
-	32, // 74: sessionhub.SessionHubService.GetSessionQuota:output_type -> sessionhub.GetSessionQuotaResponse
-	33, // 75: sessionhub.SessionHubService.CreateTeam:output_type -> sessionhub.Team
-	33, // 76: sessionhub.SessionHubService.GetTeam:output_type -> sessionhub.Team
-	33, // 77: sessionhub.SessionHubService.UpdateTeam:output_type -> sessionhub.Team
-	41, // 78: sessionhub.SessionHubService.DeleteTeam:output_type -> sessionhub.DeleteTeamResponse
-	43, // 79: sessionhub.SessionHubService.ListUserTeams:output_type -> sessionhub.ListUserTeamsResponse
-	45, // 80: sessionhub.SessionHubService.InviteMember:output_type -> sessionhub.InviteMemberResponse
-	47, // 81: sessionhub.SessionHubService.AcceptInvitation:output_type -> sessionhub.AcceptInvitationResponse
-	49, // 82: sessionhub.SessionHubService.RevokeInvitation:output_type -> sessionhub.RevokeInvitationResponse
-	51, // 83: sessionhub.SessionHubService.ListPendingInvitations:output_type -> sessionhub.ListPendingInvitationsResponse
-	53, // 84: sessionhub.SessionHubService.RemoveMember:output_type -> sessionhub.RemoveMemberResponse
-	55, // 85: sessionhub.SessionHubService.UpdateMemberRole:output_type -> sessionhub.UpdateMemberRoleResponse
-	57, // 86: sessionhub.SessionHubService.ListMembers:output_type -> sessionhub.ListMembersResponse
-	59, // 87: sessionhub.SessionHubService.TransferOwnership:output_type -> sessionhub.TransferOwnershipResponse
-	61, // 88: sessionhub.SessionHubService.GetTeamPublicKey:output_type -> sessionhub.GetTeamPublicKeyResponse
-	63, // 89: sessionhub.SessionHubService.GetUserPublicKey:output_type -> sessionhub.GetUserPublicKeyResponse
-	66, // 90: sessionhub.SessionHubService.GetTeamSkills:output_type -> sessionhub.GetTeamSkillsResponse
-	68, // 91: sessionhub.SessionHubService.CreateTeamSkill:output_type -> sessionhub.CreateTeamSkillResponse
-	61, // [61:92] is the sub-list for method output_type
-	30, // [30:61] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	75, // 1: sessionhub.CreateProjectRequest.metadata:type_name -> sessionhub.CreateProjectRequest.MetadataEntry
+	76, // 2: sessionhub.Project.metadata:type_name -> sessionhub.Project.MetadataEntry
+	22, // 3: sessionhub.CreateSessionRequest.todo_snapshots:type_name -> sessionhub.TodoSnapshot
+	24, // 4: sessionhub.CreateSessionRequest.attachment_urls:type_name -> sessionhub.AttachmentMetadata
+	20, // 5: sessionhub.CreateSessionRequest.interactions:type_name -> sessionhub.InteractionData
+	77, // 6: sessionhub.CreateSessionRequest.metadata:type_name -> sessionhub.CreateSessionRequest.MetadataEntry
+	21, // 7: sessionhub.CreateSessionRequest.file_changes:type_name -> sessionhub.FileChange
+	15, // 8: sessionhub.ListSessionsResponse.sessions:type_name -> sessionhub.Session
+	22, // 9: sessionhub.Session.todo_snapshots:type_name -> sessionhub.TodoSnapshot
+	24, // 10: sessionhub.Session.attachment_urls:type_name -> sessionhub.AttachmentMetadata
+	78, // 11: sessionhub.Session.metadata:type_name -> sessionhub.Session.MetadataEntry
+	20, // 12: sessionhub.StreamInteractionsRequest.interaction:type_name -> sessionhub.InteractionData
+	20, // 13: sessionhub.AddInteractionsBatchRequest.interactions:type_name -> sessionhub.InteractionData
+	79, // 14: sessionhub.InteractionData.metadata:type_name -> sessionhub.InteractionData.MetadataEntry
+	23, // 15: sessionhub.TodoSnapshot.todos:type_name -> sessionhub.Todo
+	27, // 16: sessionhub.GetProjectObservationsResponse.observations:type_name -> sessionhub.Observation
+	0,  // 17: sessionhub.Team.current_user_role:type_name -> sessionhub.TeamRole
+	39, // 18: sessionhub.Team.subscription:type_name -> sessionhub.TeamSubscription
+	0,  // 19: sessionhub.TeamMember.role:type_name -> sessionhub.TeamRole
+	0,  // 20: sessionhub.TeamInvitation.role:type_name -> sessionhub.TeamRole
+	1,  // 21: sessionhub.TeamSubscription.plan:type_name -> sessionhub.TeamPlan
+	36, // 22: sessionhub.ListUserTeamsResponse.teams:type_name -> sessionhub.Team
+	0,  // 23: sessionhub.InviteMemberRequest.role:type_name -> sessionhub.TeamRole
+	0,  // 24: sessionhub.AcceptInvitationResponse.role:type_name -> sessionhub.TeamRole
+	38, // 25: sessionhub.ListPendingInvitationsResponse.invitations:type_name -> sessionhub.TeamInvitation
+	0,  // 26: sessionhub.UpdateMemberRoleRequest.new_role:type_name -> sessionhub.TeamRole
+	37, // 27: sessionhub.UpdateMemberRoleResponse.member:type_name -> sessionhub.TeamMember
+	37, // 28: sessionhub.ListMembersResponse.members:type_name -> sessionhub.TeamMember
+	80, // 29: sessionhub.TeamSkillProto.files:type_name -> sessionhub.TeamSkillProto.FilesEntry
+	81, // 30: sessionhub.TeamSkillProto.bundle_files:type_name -> sessionhub.TeamSkillProto.BundleFilesEntry
+	68, // 31: sessionhub.GetTeamSkillsResponse.skills:type_name -> sessionhub.TeamSkillProto
+	82, // 32: sessionhub.CreateTeamSkillRequest.files:type_name -> sessionhub.CreateTeamSkillRequest.FilesEntry
+	83, // 33: sessionhub.CreateTeamSkillRequest.bundle_files:type_name -> sessionhub.CreateTeamSkillRequest.BundleFilesEntry
+	84, // 34: sessionhub.UpdateTeamSkillRequest.files:type_name -> sessionhub.UpdateTeamSkillRequest.FilesEntry
+	85, // 35: sessionhub.UpdateTeamSkillRequest.bundle_files:type_name -> sessionhub.UpdateTeamSkillRequest.BundleFilesEntry
+	69, // 36: sessionhub.TeamSkillProto.BundleFilesEntry.value:type_name -> sessionhub.SkillFile
+	69, // 37: sessionhub.CreateTeamSkillRequest.BundleFilesEntry.value:type_name -> sessionhub.SkillFile
+	69, // 38: sessionhub.UpdateTeamSkillRequest.BundleFilesEntry.value:type_name -> sessionhub.SkillFile
+	2,  // 39: sessionhub.SessionHubService.ValidateApiKey:input_type -> sessionhub.ValidateApiKeyRequest
+	4,  // 40: sessionhub.SessionHubService.GetProjects:input_type -> sessionhub.GetProjectsRequest
+	6,  // 41: sessionhub.SessionHubService.CreateProject:input_type -> sessionhub.CreateProjectRequest
+	8,  // 42: sessionhub.SessionHubService.CreateSession:input_type -> sessionhub.CreateSessionRequest
+	8,  // 43: sessionhub.SessionHubService.UpsertSession:input_type -> sessionhub.CreateSessionRequest
+	11, // 44: sessionhub.SessionHubService.GetSession:input_type -> sessionhub.GetSessionRequest
+	14, // 45: sessionhub.SessionHubService.UpdateSession:input_type -> sessionhub.UpdateSessionRequest
+	12, // 46: sessionhub.SessionHubService.ListSessions:input_type -> sessionhub.ListSessionsRequest
+	16, // 47: sessionhub.SessionHubService.StreamInteractions:input_type -> sessionhub.StreamInteractionsRequest
+	18, // 48: sessionhub.SessionHubService.AddInteractionsBatch:input_type -> sessionhub.AddInteractionsBatchRequest
+	25, // 49: sessionhub.SessionHubService.GetProjectObservations:input_type -> sessionhub.GetProjectObservationsRequest
+	28, // 50: sessionhub.SessionHubService.GetUserPreferences:input_type -> sessionhub.GetUserPreferencesRequest
+	30, // 51: sessionhub.SessionHubService.UploadAttachment:input_type -> sessionhub.UploadAttachmentRequest
+	32, // 52: sessionhub.SessionHubService.UploadPlanFile:input_type -> sessionhub.UploadPlanFileRequest
+	34, // 53: sessionhub.SessionHubService.GetSessionQuota:input_type -> sessionhub.GetSessionQuotaRequest
+	40, // 54: sessionhub.SessionHubService.CreateTeam:input_type -> sessionhub.CreateTeamRequest
+	41, // 55: sessionhub.SessionHubService.GetTeam:input_type -> sessionhub.GetTeamRequest
+	42, // 56: sessionhub.SessionHubService.UpdateTeam:input_type -> sessionhub.UpdateTeamRequest
+	43, // 57: sessionhub.SessionHubService.DeleteTeam:input_type -> sessionhub.DeleteTeamRequest
+	45, // 58: sessionhub.SessionHubService.ListUserTeams:input_type -> sessionhub.ListUserTeamsRequest
+	47, // 59: sessionhub.SessionHubService.InviteMember:input_type -> sessionhub.InviteMemberRequest
+	49, // 60: sessionhub.SessionHubService.AcceptInvitation:input_type -> sessionhub.AcceptInvitationRequest
+	51, // 61: sessionhub.SessionHubService.RevokeInvitation:input_type -> sessionhub.RevokeInvitationRequest
+	53, // 62: sessionhub.SessionHubService.ListPendingInvitations:input_type -> sessionhub.ListPendingInvitationsRequest
+	55, // 63: sessionhub.SessionHubService.RemoveMember:input_type -> sessionhub.RemoveMemberRequest
+	57, // 64: sessionhub.SessionHubService.UpdateMemberRole:input_type -> sessionhub.UpdateMemberRoleRequest
+	59, // 65: sessionhub.SessionHubService.ListMembers:input_type -> sessionhub.ListMembersRequest
+	61, // 66: sessionhub.SessionHubService.TransferOwnership:input_type -> sessionhub.TransferOwnershipRequest
+	63, // 67: sessionhub.SessionHubService.GetTeamPublicKey:input_type -> sessionhub.GetTeamPublicKeyRequest
+	65, // 68: sessionhub.SessionHubService.GetUserPublicKey:input_type -> sessionhub.GetUserPublicKeyRequest
+	67, // 69: sessionhub.SessionHubService.GetTeamSkills:input_type -> sessionhub.GetTeamSkillsRequest
+	71, // 70: sessionhub.SessionHubService.CreateTeamSkill:input_type -> sessionhub.CreateTeamSkillRequest
+	73, // 71: sessionhub.SessionHubService.UpdateTeamSkill:input_type -> sessionhub.UpdateTeamSkillRequest
+	3,  // 72: sessionhub.SessionHubService.ValidateApiKey:output_type -> sessionhub.ValidateApiKeyResponse
+	5,  // 73: sessionhub.SessionHubService.GetProjects:output_type -> sessionhub.GetProjectsResponse
+	7,  // 74: sessionhub.SessionHubService.CreateProject:output_type -> sessionhub.Project
+	9,  // 75: sessionhub.SessionHubService.CreateSession:output_type -> sessionhub.CreateSessionResponse
+	10, // 76: sessionhub.SessionHubService.UpsertSession:output_type -> sessionhub.UpsertSessionResponse
+	15, // 77: sessionhub.SessionHubService.GetSession:output_type -> sessionhub.Session
+	15, // 78: sessionhub.SessionHubService.UpdateSession:output_type -> sessionhub.Session
+	13, // 79: sessionhub.SessionHubService.ListSessions:output_type -> sessionhub.ListSessionsResponse
+	17, // 80: sessionhub.SessionHubService.StreamInteractions:output_type -> sessionhub.StreamInteractionsResponse
+	19, // 81: sessionhub.SessionHubService.AddInteractionsBatch:output_type -> sessionhub.AddInteractionsBatchResponse
+	26, // 82: sessionhub.SessionHubService.GetProjectObservations:output_type -> sessionhub.GetProjectObservationsResponse
+	29, // 83: sessionhub.SessionHubService.GetUserPreferences:output_type -> sessionhub.GetUserPreferencesResponse
+	31, // 84: sessionhub.SessionHubService.UploadAttachment:output_type -> sessionhub.UploadAttachmentResponse
+	33, // 85: sessionhub.SessionHubService.UploadPlanFile:output_type -> sessionhub.UploadPlanFileResponse
+	35, // 86: sessionhub.SessionHubService.GetSessionQuota:output_type -> sessionhub.GetSessionQuotaResponse
+	36, // 87: sessionhub.SessionHubService.CreateTeam:output_type -> sessionhub.Team
+	36, // 88: sessionhub.SessionHubService.GetTeam:output_type -> sessionhub.Team
+	36, // 89: sessionhub.SessionHubService.UpdateTeam:output_type -> sessionhub.Team
+	44, // 90: sessionhub.SessionHubService.DeleteTeam:output_type -> sessionhub.DeleteTeamResponse
+	46, // 91: sessionhub.SessionHubService.ListUserTeams:output_type -> sessionhub.ListUserTeamsResponse
+	48, // 92: sessionhub.SessionHubService.InviteMember:output_type -> sessionhub.InviteMemberResponse
+	50, // 93: sessionhub.SessionHubService.AcceptInvitation:output_type -> sessionhub.AcceptInvitationResponse
+	52, // 94: sessionhub.SessionHubService.RevokeInvitation:output_type -> sessionhub.RevokeInvitationResponse
+	54, // 95: sessionhub.SessionHubService.ListPendingInvitations:output_type -> sessionhub.ListPendingInvitationsResponse
+	56, // 96: sessionhub.SessionHubService.RemoveMember:output_type -> sessionhub.RemoveMemberResponse
+	58, // 97: sessionhub.SessionHubService.UpdateMemberRole:output_type -> sessionhub.UpdateMemberRoleResponse
+	60, // 98: sessionhub.SessionHubService.ListMembers:output_type -> sessionhub.ListMembersResponse
+	62, // 99: sessionhub.SessionHubService.TransferOwnership:output_type -> sessionhub.TransferOwnershipResponse
+	64, // 100: sessionhub.SessionHubService.GetTeamPublicKey:output_type -> sessionhub.GetTeamPublicKeyResponse
+	66, // 101: sessionhub.SessionHubService.GetUserPublicKey:output_type -> sessionhub.GetUserPublicKeyResponse
+	70, // 102: sessionhub.SessionHubService.GetTeamSkills:output_type -> sessionhub.GetTeamSkillsResponse
+	72, // 103: sessionhub.SessionHubService.CreateTeamSkill:output_type -> sessionhub.CreateTeamSkillResponse
+	74, // 104: sessionhub.SessionHubService.UpdateTeamSkill:output_type -> sessionhub.UpdateTeamSkillResponse
+	72, // [72:105] is the sub-list for method output_type
+	39, // [39:72] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_proto_sessionhub_proto_init() }
@@ -5551,30 +6220,34 @@ func file_proto_sessionhub_proto_init() {
 	file_proto_sessionhub_proto_msgTypes[6].OneofWrappers = []any{}
 	file_proto_sessionhub_proto_msgTypes[10].OneofWrappers = []any{}
 	file_proto_sessionhub_proto_msgTypes[11].OneofWrappers = []any{}
-	file_proto_sessionhub_proto_msgTypes[16].OneofWrappers = []any{}
-	file_proto_sessionhub_proto_msgTypes[20].OneofWrappers = []any{}
-	file_proto_sessionhub_proto_msgTypes[22].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[12].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[13].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[18].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[19].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[23].OneofWrappers = []any{}
 	file_proto_sessionhub_proto_msgTypes[25].OneofWrappers = []any{}
-	file_proto_sessionhub_proto_msgTypes[31].OneofWrappers = []any{}
-	file_proto_sessionhub_proto_msgTypes[32].OneofWrappers = []any{}
-	file_proto_sessionhub_proto_msgTypes[33].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[28].OneofWrappers = []any{}
 	file_proto_sessionhub_proto_msgTypes[34].OneofWrappers = []any{}
 	file_proto_sessionhub_proto_msgTypes[35].OneofWrappers = []any{}
-	file_proto_sessionhub_proto_msgTypes[36].OneofWrappers = []any{
+	file_proto_sessionhub_proto_msgTypes[36].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[37].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[38].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[39].OneofWrappers = []any{
 		(*GetTeamRequest_Id)(nil),
 		(*GetTeamRequest_Slug)(nil),
 	}
-	file_proto_sessionhub_proto_msgTypes[37].OneofWrappers = []any{}
-	file_proto_sessionhub_proto_msgTypes[62].OneofWrappers = []any{}
-	file_proto_sessionhub_proto_msgTypes[63].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[40].OneofWrappers = []any{}
 	file_proto_sessionhub_proto_msgTypes[65].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[66].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[69].OneofWrappers = []any{}
+	file_proto_sessionhub_proto_msgTypes[71].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_sessionhub_proto_rawDesc), len(file_proto_sessionhub_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   74,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,7 @@ const (
 	SessionHubService_UpsertSession_FullMethodName          = "/sessionhub.SessionHubService/UpsertSession"
 	SessionHubService_GetSession_FullMethodName             = "/sessionhub.SessionHubService/GetSession"
 	SessionHubService_UpdateSession_FullMethodName          = "/sessionhub.SessionHubService/UpdateSession"
+	SessionHubService_ListSessions_FullMethodName           = "/sessionhub.SessionHubService/ListSessions"
 	SessionHubService_StreamInteractions_FullMethodName     = "/sessionhub.SessionHubService/StreamInteractions"
 	SessionHubService_AddInteractionsBatch_FullMethodName   = "/sessionhub.SessionHubService/AddInteractionsBatch"
 	SessionHubService_GetProjectObservations_FullMethodName = "/sessionhub.SessionHubService/GetProjectObservations"
@@ -50,6 +51,7 @@ const (
 	SessionHubService_GetUserPublicKey_FullMethodName       = "/sessionhub.SessionHubService/GetUserPublicKey"
 	SessionHubService_GetTeamSkills_FullMethodName          = "/sessionhub.SessionHubService/GetTeamSkills"
 	SessionHubService_CreateTeamSkill_FullMethodName        = "/sessionhub.SessionHubService/CreateTeamSkill"
+	SessionHubService_UpdateTeamSkill_FullMethodName        = "/sessionhub.SessionHubService/UpdateTeamSkill"
 )
 
 // SessionHubServiceClient is the client API for SessionHubService service.
@@ -68,6 +70,7 @@ type SessionHubServiceClient interface {
 	UpsertSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*UpsertSessionResponse, error)
 	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*Session, error)
 	UpdateSession(ctx context.Context, in *UpdateSessionRequest, opts ...grpc.CallOption) (*Session, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
 	// Interactions - Streaming for large imports
 	StreamInteractions(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamInteractionsRequest, StreamInteractionsResponse], error)
 	// Batch operations (alternative to streaming)
@@ -105,6 +108,8 @@ type SessionHubServiceClient interface {
 	GetTeamSkills(ctx context.Context, in *GetTeamSkillsRequest, opts ...grpc.CallOption) (*GetTeamSkillsResponse, error)
 	// Create a team skill draft from the plugin (local → team push)
 	CreateTeamSkill(ctx context.Context, in *CreateTeamSkillRequest, opts ...grpc.CallOption) (*CreateTeamSkillResponse, error)
+	// Submit a new version draft of an existing team skill (local → team push)
+	UpdateTeamSkill(ctx context.Context, in *UpdateTeamSkillRequest, opts ...grpc.CallOption) (*UpdateTeamSkillResponse, error)
 }
 
 type sessionHubServiceClient struct {
@@ -185,6 +190,16 @@ func (c *sessionHubServiceClient) UpdateSession(ctx context.Context, in *UpdateS
 	return out, nil
 }
 
+func (c *sessionHubServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, SessionHubService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sessionHubServiceClient) StreamInteractions(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamInteractionsRequest, StreamInteractionsResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &SessionHubService_ServiceDesc.Streams[0], SessionHubService_StreamInteractions_FullMethodName, cOpts...)
@@ -428,6 +443,16 @@ func (c *sessionHubServiceClient) CreateTeamSkill(ctx context.Context, in *Creat
 	return out, nil
 }
 
+func (c *sessionHubServiceClient) UpdateTeamSkill(ctx context.Context, in *UpdateTeamSkillRequest, opts ...grpc.CallOption) (*UpdateTeamSkillResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTeamSkillResponse)
+	err := c.cc.Invoke(ctx, SessionHubService_UpdateTeamSkill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionHubServiceServer is the server API for SessionHubService service.
 // All implementations must embed UnimplementedSessionHubServiceServer
 // for forward compatibility.
@@ -444,6 +469,7 @@ type SessionHubServiceServer interface {
 	UpsertSession(context.Context, *CreateSessionRequest) (*UpsertSessionResponse, error)
 	GetSession(context.Context, *GetSessionRequest) (*Session, error)
 	UpdateSession(context.Context, *UpdateSessionRequest) (*Session, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
 	// Interactions - Streaming for large imports
 	StreamInteractions(grpc.ClientStreamingServer[StreamInteractionsRequest, StreamInteractionsResponse]) error
 	// Batch operations (alternative to streaming)
@@ -481,6 +507,8 @@ type SessionHubServiceServer interface {
 	GetTeamSkills(context.Context, *GetTeamSkillsRequest) (*GetTeamSkillsResponse, error)
 	// Create a team skill draft from the plugin (local → team push)
 	CreateTeamSkill(context.Context, *CreateTeamSkillRequest) (*CreateTeamSkillResponse, error)
+	// Submit a new version draft of an existing team skill (local → team push)
+	UpdateTeamSkill(context.Context, *UpdateTeamSkillRequest) (*UpdateTeamSkillResponse, error)
 	mustEmbedUnimplementedSessionHubServiceServer()
 }
 
@@ -512,6 +540,9 @@ func (UnimplementedSessionHubServiceServer) GetSession(context.Context, *GetSess
 func (UnimplementedSessionHubServiceServer) UpdateSession(context.Context, *UpdateSessionRequest) (*Session, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSession not implemented")
 }
+func (UnimplementedSessionHubServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
 func (UnimplementedSessionHubServiceServer) StreamInteractions(grpc.ClientStreamingServer[StreamInteractionsRequest, StreamInteractionsResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamInteractions not implemented")
 }
@@ -584,6 +615,9 @@ func (UnimplementedSessionHubServiceServer) GetTeamSkills(context.Context, *GetT
 func (UnimplementedSessionHubServiceServer) CreateTeamSkill(context.Context, *CreateTeamSkillRequest) (*CreateTeamSkillResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamSkill not implemented")
 }
+func (UnimplementedSessionHubServiceServer) UpdateTeamSkill(context.Context, *UpdateTeamSkillRequest) (*UpdateTeamSkillResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamSkill not implemented")
+}
 func (UnimplementedSessionHubServiceServer) mustEmbedUnimplementedSessionHubServiceServer() {}
 func (UnimplementedSessionHubServiceServer) testEmbeddedByValue()                           {}
 
@@ -731,6 +765,24 @@ func _SessionHubService_UpdateSession_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionHubService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionHubServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionHubService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionHubServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SessionHubService_StreamInteractions_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(SessionHubServiceServer).StreamInteractions(&grpc.GenericServerStream[StreamInteractionsRequest, StreamInteractionsResponse]{ServerStream: stream})
 }
@@ -1152,6 +1204,24 @@ func _SessionHubService_CreateTeamSkill_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionHubService_UpdateTeamSkill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamSkillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionHubServiceServer).UpdateTeamSkill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionHubService_UpdateTeamSkill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionHubServiceServer).UpdateTeamSkill(ctx, req.(*UpdateTeamSkillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionHubService_ServiceDesc is the grpc.ServiceDesc for SessionHubService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1187,6 +1257,10 @@ var SessionHubService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSession",
 			Handler:    _SessionHubService_UpdateSession_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _SessionHubService_ListSessions_Handler,
+		},
 		{
 			MethodName: "AddInteractionsBatch",
 			Handler:    _SessionHubService_AddInteractionsBatch_Handler,
@@ -1279,6 +1353,10 @@ var SessionHubService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTeamSkill",
 			Handler:    _SessionHubService_CreateTeamSkill_Handler,
 		},
+		{
+			MethodName: "UpdateTeamSkill",
+			Handler:    _SessionHubService_UpdateTeamSkill_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{